@@ -0,0 +1,280 @@
+/*
+ * Goplex Barnes-Hut Approximation
+ *
+ * Description: An octree of the bodies of a collection, which lets the
+ *              pull of a distant cluster be treated as that of a single
+ *              body at its center of mass, bringing the cost of the n-body
+ *              accelerations down from O(n^2) to around O(n log n).
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"math"
+)
+
+//
+// Globals
+//
+var (
+
+	// depth past which bodies are no longer split apart, so that bodies
+	// sitting at the same point do not subdivide the tree forever
+	barnesHutMaxDepth = 64
+)
+
+// octreeNode is a cube of space holding some of the bodies of a collection,
+// split into eight smaller cubes once it holds more than one of them.
+type octreeNode struct {
+
+	// center of the cube, and half the length of its sides
+	center    Vec3
+	halfWidth float64
+
+	// number of bodies inside the cube, and their total mass
+	count int
+	mass  float64
+
+	// center of mass of the bodies inside the cube
+	centerOfMass Vec3
+
+	// index of the body, if the cube holds only the one, otherwise -1
+	body int
+
+	// smaller cubes, nil until the cube is split
+	children *[8]octreeNode
+
+	// bodies of a cube at the greatest depth, which is never split, so
+	// that their pull can be summed one by one
+	bodies []octreeBody
+}
+
+// octreeBody is one of several bodies held by a cube at the greatest depth.
+type octreeBody struct {
+	index    int
+	mass     float64
+	position Vec3
+}
+
+//! Place a body into the cube, splitting it if it is already occupied
+/*
+ * @param    int        index of the body       --> i
+ * @param    float64    mass of the body, in kg --> m
+ * @param    Vec3       position of the body    --> p
+ * @param    int        depth of the cube       --> depth
+ */
+func (n *octreeNode) insert(i int, m float64, p Vec3, depth int) {
+
+	// an empty cube simply takes on the body
+	if n.count == 0 {
+		n.count, n.mass, n.centerOfMass, n.body = 1, m, p, i
+		return
+	}
+
+	// move the body already here down into one of the smaller cubes
+	if n.children == nil && depth < barnesHutMaxDepth {
+		n.children = new([8]octreeNode)
+		for k := range n.children {
+			offset := n.halfWidth / 2
+			n.children[k] = octreeNode{
+				center: n.center.Add(Vec3{
+					X: octantSign(k, 1) * offset,
+					Y: octantSign(k, 2) * offset,
+					Z: octantSign(k, 4) * offset,
+				}),
+				halfWidth: offset,
+				body:      -1,
+			}
+		}
+		if n.body >= 0 {
+			n.children[n.octant(n.centerOfMass)].insert(n.body, n.mass,
+				n.centerOfMass, depth+1)
+		}
+	}
+
+	if n.children != nil {
+		n.children[n.octant(p)].insert(i, m, p, depth+1)
+	} else {
+
+		// a cube at the greatest depth keeps a list of its bodies instead
+		if n.body >= 0 {
+			n.bodies = append(n.bodies,
+				octreeBody{n.body, n.mass, n.centerOfMass})
+		}
+		n.bodies = append(n.bodies, octreeBody{i, m, p})
+	}
+	n.body = -1
+
+	// fold the body into the running center of mass
+	total := n.mass + m
+	if total != 0.0 {
+		n.centerOfMass = n.centerOfMass.Scale(n.mass / total).Add(
+			p.Scale(m / total))
+	}
+	n.mass = total
+	n.count++
+}
+
+//! Which of the eight smaller cubes a point falls into
+/*
+ * @param    Vec3    point --> p
+ *
+ * @result   int     index of the smaller cube, one bit per axis
+ */
+func (n *octreeNode) octant(p Vec3) int {
+
+	k := 0
+	if p.X >= n.center.X {
+		k |= 1
+	}
+	if p.Y >= n.center.Y {
+		k |= 2
+	}
+	if p.Z >= n.center.Z {
+		k |= 4
+	}
+
+	return k
+}
+
+//! Direction of a smaller cube from the center along one axis
+/*
+ * @param    int        index of the smaller cube  --> k
+ * @param    int        bit of the axis            --> bit
+ *
+ * @result   float64    +1 or -1
+ */
+func octantSign(k, bit int) float64 {
+	if k&bit != 0 {
+		return 1.0
+	}
+	return -1.0
+}
+
+//! Whether a point lies within the cube
+/*
+ * @param    Vec3    point --> p
+ *
+ * @result   bool    true if the point is inside or on the cube
+ */
+func (n *octreeNode) contains(p Vec3) bool {
+	return math.Abs(p.X-n.center.X) <= n.halfWidth &&
+		math.Abs(p.Y-n.center.Y) <= n.halfWidth &&
+		math.Abs(p.Z-n.center.Z) <= n.halfWidth
+}
+
+//! Gravitational acceleration of a body due to the bodies in the cube
+/*
+ * @param    Vec3       position of the body      --> point
+ * @param    int        index of the body         --> self
+ * @param    float64    opening angle             --> theta
+ *
+ * @result   Vec3       gravitational acceleration, in m/s^2
+ */
+func (n *octreeNode) accelerationAt(point Vec3, self int,
+	theta float64) Vec3 {
+
+	var field Vec3
+
+	// an empty cube, or the body on its own, exerts no pull
+	if n.count == 0 || n.body == self {
+		return field
+	}
+
+	// sum the bodies of a cube at the greatest depth directly, as the body
+	// itself may be among them
+	if n.bodies != nil {
+		for _, b := range n.bodies {
+
+			separation := b.position.Sub(point)
+			r := separation.Norm()
+
+			// skip the body itself, and any that coincide with it
+			if b.index == self || r == 0.0 {
+				continue
+			}
+
+			field = field.Add(separation.Scale(
+				DefaultConfig.G * b.mass / (r * r * r)))
+		}
+		return field
+	}
+
+	separation := n.centerOfMass.Sub(point)
+	r := separation.Norm()
+
+	// a cube that is small enough, as seen from the point, is treated as
+	// a single body; the cube around the point itself is always opened
+	if n.children == nil ||
+		(!n.contains(point) && 2*n.halfWidth < theta*r) {
+
+		// skip bodies that coincide with the point
+		if r == 0.0 {
+			return field
+		}
+
+		return separation.Scale(DefaultConfig.G * n.mass / (r * r * r))
+	}
+
+	for k := range n.children {
+		field = field.Add(n.children[k].accelerationAt(point, self, theta))
+	}
+
+	return field
+}
+
+//! Function to approximate the gravitational acceleration of every body
+//! in a collection, due to all of the others, via a Barnes-Hut octree.
+//! The opening angle sets the trade of accuracy for speed: a cube is
+//! treated as a single body when its width is less than theta times its
+//! distance, so theta = 0 gives the direct sum and around 0.5 is typical.
+/*
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    []Vec3       positions of the bodies        --> positions
+ * @param    float64      opening angle                  --> theta
+ *
+ * @result   []Vec3       acceleration of each body, in m/s^2
+ */
+func barnesHutAccelerations(masses []float64, positions []Vec3,
+	theta float64) []Vec3 {
+
+	accelerations := make([]Vec3, len(positions))
+
+	// input validation
+	if len(masses) != len(positions) || len(positions) == 0 {
+		return accelerations
+	}
+
+	// find the smallest cube around the bodies
+	lo, hi := positions[0], positions[0]
+	for _, p := range positions {
+		lo = Vec3{math.Min(lo.X, p.X), math.Min(lo.Y, p.Y),
+			math.Min(lo.Z, p.Z)}
+		hi = Vec3{math.Max(hi.X, p.X), math.Max(hi.Y, p.Y),
+			math.Max(hi.Z, p.Z)}
+	}
+	extent := hi.Sub(lo)
+	root := octreeNode{
+		center:    lo.Add(hi).Scale(0.5),
+		halfWidth: math.Max(extent.X, math.Max(extent.Y, extent.Z)) / 2,
+		body:      -1,
+	}
+
+	for i, p := range positions {
+		root.insert(i, masses[i], p, 0)
+	}
+
+	for i, p := range positions {
+		accelerations[i] = root.accelerationAt(p, i, theta)
+	}
+
+	return accelerations
+}
@@ -0,0 +1,114 @@
+//go:build bench
+
+/*
+ * Goplex Benchmarks
+ *
+ * Description: Benchmarks of the performance sensitive functions, run via
+ *              the -bench flag rather than as part of the regular tests.
+ *              Only built with the bench tag, e.g. via `make bench`, so
+ *              that the testing package stays out of the regular binary.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+//
+// Globals
+//
+var (
+
+	// result of each benchmark, kept so the work is not optimized away
+	benchmarkSink float64
+
+	// number of bodies in the n-body benchmarks
+	benchmarkBodyCount = 1000
+)
+
+//! Benchmark the approximate inverse square root
+func benchmarkFastInvSqrt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkSink += fastInvSqrt(float64(i + 1))
+	}
+}
+
+//! Benchmark the exact inverse square root, for comparison
+func benchmarkInvSqrt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkSink += 1 / math.Sqrt(float64(i+1))
+	}
+}
+
+//! Scatter a number of Earth-like bodies at random, always the same way
+/*
+ * @param    int          number of bodies --> n
+ *
+ * @result   []float64    masses of the bodies
+ *           []Vec3       positions of the bodies
+ */
+func benchmarkBodies(n int) ([]float64, []Vec3) {
+
+	rng := rand.New(rand.NewSource(1))
+	masses := make([]float64, n)
+	positions := make([]Vec3, n)
+	for i := range masses {
+		masses[i] = massOfTheEarth
+		positions[i] = Vec3{
+			astronomicalUnit * rng.NormFloat64(),
+			astronomicalUnit * rng.NormFloat64(),
+			astronomicalUnit * rng.NormFloat64(),
+		}
+	}
+
+	return masses, positions
+}
+
+//! Benchmark the direct sum of the n-body accelerations
+func benchmarkNBodyAccelerations(b *testing.B) {
+	masses, positions := benchmarkBodies(benchmarkBodyCount)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkSink += nBodyAccelerations(masses, positions)[0].X
+	}
+}
+
+//! Benchmark the Barnes-Hut approximation of the n-body accelerations
+func benchmarkBarnesHutAccelerations(b *testing.B) {
+	masses, positions := benchmarkBodies(benchmarkBodyCount)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkSink += barnesHutAccelerations(masses, positions, 0.5)[0].X
+	}
+}
+
+//! Run each of the benchmarks and print their timings
+func runBenchmarks() {
+
+	benchmarks := []struct {
+		name string
+		fn   func(b *testing.B)
+	}{
+		{"fastInvSqrt", benchmarkFastInvSqrt},
+		{"1/math.Sqrt", benchmarkInvSqrt},
+		{"nBodyAccelerations", benchmarkNBodyAccelerations},
+		{"barnesHutAccelerations", benchmarkBarnesHutAccelerations},
+	}
+
+	for _, benchmark := range benchmarks {
+		result := testing.Benchmark(benchmark.fn)
+		fmt.Printf("%-24s %s\n", benchmark.name, result)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Goplex Arbitrary Precision
+ *
+ * Description: Versions of the functions that calculate with big.Float,
+ *              to a precision and rounding mode given by a BigConfig,
+ *              for when float64 is not precise enough.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"math/big"
+)
+
+// BigConfig sets how the big.Float functions of this package calculate.
+// Call a function as a method of a BigConfig to evaluate it with those
+// settings, or as a plain function to use DefaultBigConfig.
+type BigConfig struct {
+
+	// Precision of each result, in bits of mantissa
+	Precision uint
+
+	// Rounding mode of each operation
+	RoundingMode big.RoundingMode
+}
+
+//
+// Globals
+//
+var (
+
+	// Default settings, which round to nearest at 128 bits
+	DefaultBigConfig = &BigConfig{
+		Precision:    128,
+		RoundingMode: big.ToNearestEven,
+	}
+)
+
+//! Create a new big.Float with the precision and rounding mode of the
+//! config
+/*
+ * @param    float64       initial value --> x
+ *
+ * @result   *big.Float    new value
+ */
+func (cfg *BigConfig) newFloat(x float64) *big.Float {
+	return new(big.Float).SetPrec(cfg.Precision).
+		SetMode(cfg.RoundingMode).SetFloat64(x)
+}
+
+//! Energy of a photon, as per photonEnergy, in arbitrary precision
+/*
+ * @param    float64       wavelength --> l
+ *
+ * @result   *big.Float    energy of a photon, in Joules
+ */
+func (cfg *BigConfig) photonEnergyBig(l float64) *big.Float {
+
+	// if wavelength is zero, return zero
+	if l == 0 {
+		return cfg.newFloat(0)
+	}
+
+	// compare the wavelength to the planck constant w/ speed of light
+	// and then obtain the ratio of that to the wavelength
+	energy := cfg.newFloat(planckConstant)
+	energy.Mul(energy, cfg.newFloat(c))
+
+	return energy.Quo(energy, cfg.newFloat(l))
+}
+
+//
+// Package-level functions, evaluated with the default settings
+//
+
+func photonEnergyBig(l float64) *big.Float {
+	return DefaultBigConfig.photonEnergyBig(l)
+}
@@ -0,0 +1,139 @@
+/*
+ * Goplex Bodies
+ *
+ * Description: Celestial bodies described by their name, mass and radius,
+ *              along with a set of well known ones.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Body is a spherical celestial body.
+type Body struct {
+
+	// name of the body, e.g. "Earth"
+	Name string
+
+	// mass of the body, in kilograms
+	Mass float64
+
+	// mean radius of the body, in metres
+	Radius float64
+}
+
+// bodyJSON is the serialized form of a Body.
+type bodyJSON struct {
+	Name   string  `json:"name"`
+	Mass   float64 `json:"mass"`
+	Radius float64 `json:"radius"`
+}
+
+//! Serialize a body, e.g. {"name":"Earth","mass":5.97237e+24,...}
+/*
+ * @result   []byte    JSON form of the body
+ *           error     error message, if any
+ */
+func (b Body) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bodyJSON(b))
+}
+
+//! Deserialize a body
+/*
+ * @param    []byte    JSON form of the body --> data
+ *
+ * @result   error     error message, if any
+ */
+func (b *Body) UnmarshalJSON(data []byte) error {
+
+	var decoded bodyJSON
+	err := json.Unmarshal(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	*b = Body(decoded)
+	return nil
+}
+
+//
+// Globals
+//
+var (
+
+	// the planet Earth
+	Earth = Body{"Earth", massOfTheEarth, radiusOfTheEarth}
+
+	// the Moon of the planet Earth
+	Moon = Body{"Moon", massOfTheMoon, radiusOfTheMoon}
+
+	// the Sun
+	Sun = Body{"Sun", massOfTheSun, radiusOfTheSun}
+)
+
+//! Function to calculate the surface gravity of a list of bodies
+/*
+ * @param    []Body                bodies to compare --> bodies
+ *
+ * @result   map[string]float64    surface gravity of each body, by name
+ */
+func compareSurfaceGravity(bodies []Body) map[string]float64 {
+
+	gravities := make(map[string]float64)
+
+	for _, body := range bodies {
+
+		// skip any body without a radius
+		if body.Radius == 0.0 {
+			continue
+		}
+
+		gravities[body.Name] = surfaceGravity(body.Mass, body.Radius)
+	}
+
+	return gravities
+}
+
+//! Read a list of bodies, e.g. from a JSON config file, ensuring each of
+//! them has a positive mass and radius
+/*
+ * @param    io.Reader    JSON array of bodies --> r
+ *
+ * @result   []Body       list of bodies
+ *           error        error message, if any
+ */
+func LoadBodies(r io.Reader) ([]Body, error) {
+
+	var bodies []Body
+	err := json.NewDecoder(r).Decode(&bodies)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse bodies: %v", err)
+	}
+
+	for i, body := range bodies {
+
+		if body.Mass <= 0.0 {
+			return nil, fmt.Errorf("body %d (%q): mass must be positive, "+
+				"got %g", i, body.Name, body.Mass)
+		}
+
+		if body.Radius <= 0.0 {
+			return nil, fmt.Errorf("body %d (%q): radius must be "+
+				"positive, got %g", i, body.Name, body.Radius)
+		}
+	}
+
+	return bodies, nil
+}
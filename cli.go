@@ -0,0 +1,165 @@
+/*
+ * Goplex Command Line
+ *
+ * Description: Evaluates a function of the registry from the command line,
+ *              e.g. goplex photonEnergy --precision 3 l=4e-7, or lists them
+ *              via goplex version
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"flag"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//! Evaluate the function named by the first argument, using the remaining
+//! name=value arguments, and write the result
+/*
+ * @param    []string     arguments, after the program name --> args
+ * @param    io.Writer    where the result is written         --> w
+ *
+ * @result   error        error message, if any
+ */
+func dispatch(args []string, w io.Writer) error {
+
+	if len(args) == 0 {
+		return fmt.Errorf("usage: goplex <function> [--precision N] " +
+			"name=value ...")
+	}
+
+	if args[0] == "version" {
+		return printVersion(w)
+	}
+
+	spec, ok := Registry[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown function: %s", args[0])
+	}
+
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	precision := fs.Int("precision", 0,
+		"significant figures of the result, or 0 for full precision")
+
+	// flags may appear anywhere among the name=value arguments
+	var positional []string
+	rest := args[1:]
+	for {
+		err := fs.Parse(rest)
+		if err != nil {
+			return err
+		}
+
+		rest = fs.Args()
+		if len(rest) == 0 {
+			break
+		}
+
+		positional = append(positional, rest[0])
+		rest = rest[1:]
+	}
+
+	// the names of the parameters the function takes
+	known := make(map[string]bool)
+	for _, param := range spec.Params {
+		known[param.Name] = true
+	}
+
+	// convert each of the name=value arguments
+	values := make(map[string]float64)
+	for _, arg := range positional {
+
+		pieces := strings.SplitN(arg, "=", 2)
+		if len(pieces) != 2 {
+			return fmt.Errorf("expected name=value, got %q", arg)
+		}
+
+		if !known[pieces[0]] {
+			return fmt.Errorf("unknown parameter: %s", pieces[0])
+		}
+
+		if _, ok := values[pieces[0]]; ok {
+			return fmt.Errorf("repeated parameter: %s", pieces[0])
+		}
+
+		value, err := strconv.ParseFloat(pieces[1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %q", pieces[0],
+				pieces[1])
+		}
+		values[pieces[0]] = value
+	}
+
+	result, err := spec.Eval(values)
+	if err != nil {
+		return err
+	}
+
+	result = roundToSigFigs(result, *precision)
+
+	_, err = fmt.Fprintln(w, strconv.FormatFloat(result, 'g', -1, 64))
+	return err
+}
+
+//! Names of every function in the registry, in alphabetical order
+/*
+ * @result   []string    names of the functions
+ */
+func supportedFunctions() []string {
+
+	names := make([]string, 0, len(Registry))
+	for name := range Registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//! Write the version of this build, followed by the functions it supports
+/*
+ * @param    io.Writer    where the version is written --> w
+ *
+ * @result   error        error message, if any
+ */
+func printVersion(w io.Writer) error {
+
+	// builds outside of a module, e.g. via GOPATH, carry no version
+	version := "(devel)"
+	info, ok := debug.ReadBuildInfo()
+	if ok && info.Main.Version != "" {
+		version = info.Main.Version
+	}
+
+	_, err := fmt.Fprintln(w, "goplex "+version)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, "functions:")
+	if err != nil {
+		return err
+	}
+
+	for _, name := range supportedFunctions() {
+		_, err = fmt.Fprintln(w, "    "+name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
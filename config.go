@@ -0,0 +1,295 @@
+/*
+ * Goplex Configuration
+ *
+ * Description: A set of physical constants that can be overridden, e.g. to
+ *              work in CGS units or with newer CODATA values, along with
+ *              package-level wrappers that use the default set.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+// Config holds the physical constants used by the scalar functions of
+// this package, i.e. those of funcs.go. Call a function as a method of a
+// Config to evaluate it with those constants, or as a plain function to use
+// DefaultConfig. The n-body functions and the typed measurements use the
+// constants of DefaultConfig, while the big.Float functions always use the
+// SI constants.
+type Config struct {
+
+	// Speed of light in a vaccuum
+	C float64
+
+	// Universal gravitational constant
+	G float64
+
+	// Planck constant
+	PlanckConstant float64
+
+	// Boltzmann constant, in energy per Kelvin
+	BoltzmannConstantJoules float64
+
+	// Boltzmann constant, in eV per Kelvin
+	BoltzmannConstantEv float64
+
+	// Permittivity of free space
+	VacuumPermittivity float64
+}
+
+//
+// Globals
+//
+var (
+
+	// Default set of constants, in SI units
+	DefaultConfig = &Config{
+		C:                       c,
+		G:                       universalGravitationConstant,
+		PlanckConstant:          planckConstant,
+		BoltzmannConstantJoules: boltzmannConstantJoules,
+		BoltzmannConstantEv:     boltzmannConstantEv,
+		VacuumPermittivity:      vacuumPermittivity,
+	}
+)
+
+//
+// Package-level functions, evaluated with the default constants
+//
+
+func photonEnergy(l float64) float64 {
+	return DefaultConfig.photonEnergy(l)
+}
+
+func thermalVelocityOfHeatedGas(g float64, T float64, m float64) float64 {
+	return DefaultConfig.thermalVelocityOfHeatedGas(g, T, m)
+}
+
+func lorentzFactor(v float64) float64 {
+	return DefaultConfig.lorentzFactor(v)
+}
+
+func abrahamLorentzForce(q float64, e0 float64, a float64) float64 {
+	return DefaultConfig.abrahamLorentzForce(q, e0, a)
+}
+
+func perihelionShift(L float64, T float64, e float64) float64 {
+	return DefaultConfig.perihelionShift(L, T, e)
+}
+
+func schwarzschildRadius(M float64) float64 {
+	return DefaultConfig.schwarzschildRadius(M)
+}
+
+func gravityAtDepth(M float64, R float64, r float64) float64 {
+	return DefaultConfig.gravityAtDepth(M, R, r)
+}
+
+func tidalAcceleration(M float64, r float64, dr float64) float64 {
+	return DefaultConfig.tidalAcceleration(M, r, dr)
+}
+
+func gravitationalTimeDilation(M float64, r float64) float64 {
+	return DefaultConfig.gravitationalTimeDilation(M, r)
+}
+
+func netClockRate(M float64, r float64, v float64) float64 {
+	return DefaultConfig.netClockRate(M, r, v)
+}
+
+func jeansMass(T float64, density float64, meanMolecularMass float64) float64 {
+	return DefaultConfig.jeansMass(T, density, meanMolecularMass)
+}
+
+func freeFallTime(density float64) float64 {
+	return DefaultConfig.freeFallTime(density)
+}
+
+func rapidityFromVelocity(v float64) float64 {
+	return DefaultConfig.rapidityFromVelocity(v)
+}
+
+func velocityFromRapidity(phi float64) float64 {
+	return DefaultConfig.velocityFromRapidity(phi)
+}
+
+func larmorPower(q float64, a float64) float64 {
+	return DefaultConfig.larmorPower(q, a)
+}
+
+func photonSphereRadius(M float64) float64 {
+	return DefaultConfig.photonSphereRadius(M)
+}
+
+func iscoRadius(M float64) float64 {
+	return DefaultConfig.iscoRadius(M)
+}
+
+func surfaceGravity(M float64, r float64) float64 {
+	return DefaultConfig.surfaceGravity(M, r)
+}
+
+func sahaRatio(T, electronDensity, ionizationEnergy float64) float64 {
+	return DefaultConfig.sahaRatio(T, electronDensity, ionizationEnergy)
+}
+
+func photonFlux(luminosity float64, wavelength float64,
+	distance float64) float64 {
+	return DefaultConfig.photonFlux(luminosity, wavelength, distance)
+}
+
+func relativisticMass(m0 float64, v float64) float64 {
+	return DefaultConfig.relativisticMass(m0, v)
+}
+
+func gravitationalForce(m1, m2, r float64) float64 {
+	return DefaultConfig.gravitationalForce(m1, m2, r)
+}
+
+func gravitationalForceSoftened(m1, m2, r, softening float64) float64 {
+	return DefaultConfig.gravitationalForceSoftened(m1, m2, r, softening)
+}
+
+func gravitationalPotentialEnergy(m1, m2, r float64) float64 {
+	return DefaultConfig.gravitationalPotentialEnergy(m1, m2, r)
+}
+
+func einsteinRadius(M, dL, dS, dLS float64) float64 {
+	return DefaultConfig.einsteinRadius(M, dL, dS, dLS)
+}
+
+func gravityFromRing(M, ringRadius, axialDistance float64) float64 {
+	return DefaultConfig.gravityFromRing(M, ringRadius, axialDistance)
+}
+
+func dopplerFactor(theta float64, v float64) float64 {
+	return DefaultConfig.dopplerFactor(theta, v)
+}
+
+func photonEnergyEv(l float64) float64 {
+	return DefaultConfig.photonEnergyEv(l)
+}
+
+func gravitationalRedshift(M float64, r float64) float64 {
+	return DefaultConfig.gravitationalRedshift(M, r)
+}
+
+func redshiftedWavelength(l0, M, r float64) float64 {
+	return DefaultConfig.redshiftedWavelength(l0, M, r)
+}
+
+func schwarzschildRadii(masses []float64) []float64 {
+	return DefaultConfig.schwarzschildRadii(masses)
+}
+
+func energyMomentumRelation(m, p float64) float64 {
+	return DefaultConfig.energyMomentumRelation(m, p)
+}
+
+func gravitationalWaveStrain(m1, m2, r, distance float64) float64 {
+	return DefaultConfig.gravitationalWaveStrain(m1, m2, r, distance)
+}
+
+func relativisticPerihelionPrecession(M, a, e float64) float64 {
+	return DefaultConfig.relativisticPerihelionPrecession(M, a, e)
+}
+
+func captureCrossSection(M, r, vInfinity float64) float64 {
+	return DefaultConfig.captureCrossSection(M, r, vInfinity)
+}
+
+func escapeVelocity(M float64, r float64) float64 {
+	return DefaultConfig.escapeVelocity(M, r)
+}
+
+func circularOrbitalVelocity(M float64, r float64) float64 {
+	return DefaultConfig.circularOrbitalVelocity(M, r)
+}
+
+func orbitalVelocityDifference(M, r1, r2 float64) float64 {
+	return DefaultConfig.orbitalVelocityDifference(M, r1, r2)
+}
+
+func properAcceleration(v, coordinateAccel float64) float64 {
+	return DefaultConfig.properAcceleration(v, coordinateAccel)
+}
+
+func relativisticKineticEnergy(m, v float64) float64 {
+	return DefaultConfig.relativisticKineticEnergy(m, v)
+}
+
+func relativisticKineticEnergyEv(m, v float64) float64 {
+	return DefaultConfig.relativisticKineticEnergyEv(m, v)
+}
+
+func radialFreeFallTime(M, r0, rFinal float64) float64 {
+	return DefaultConfig.radialFreeFallTime(M, r0, rFinal)
+}
+
+func grCorrectionFactor(M, r float64) float64 {
+	return DefaultConfig.grCorrectionFactor(M, r)
+}
+
+func lorentzFactorFromKineticEnergy(m, ke float64) float64 {
+	return DefaultConfig.lorentzFactorFromKineticEnergy(m, ke)
+}
+
+func dopplerFactorDeg(thetaDeg float64, v float64) float64 {
+	return DefaultConfig.dopplerFactorDeg(thetaDeg, v)
+}
+
+func invariantMass(E float64, p Vec3) float64 {
+	return DefaultConfig.invariantMass(E, p)
+}
+
+func shellModelGravity(shellMasses []float64, shellRadii []float64,
+	r float64) float64 {
+	return DefaultConfig.shellModelGravity(shellMasses, shellRadii, r)
+}
+
+func degeneracyPressure(numberDensity float64) float64 {
+	return DefaultConfig.degeneracyPressure(numberDensity)
+}
+
+func orbitalFrequencyFromSeparation(m1, m2, r float64) float64 {
+	return DefaultConfig.orbitalFrequencyFromSeparation(m1, m2, r)
+}
+
+func lightDeflectionAngle(M, b float64) float64 {
+	return DefaultConfig.lightDeflectionAngle(M, b)
+}
+
+func impactParameterForDeflection(M, deflectionAngle float64) float64 {
+	return DefaultConfig.impactParameterForDeflection(M, deflectionAngle)
+}
+
+func planckSpectralRadiance(l, T float64) float64 {
+	return DefaultConfig.planckSpectralRadiance(l, T)
+}
+
+func integratedPlanckRadiance(T float64) float64 {
+	return DefaultConfig.integratedPlanckRadiance(T)
+}
+
+func surfaceGravityFromDensity(density, radius float64) float64 {
+	return DefaultConfig.surfaceGravityFromDensity(density, radius)
+}
+
+func transverseDopplerShift(f0, v float64) float64 {
+	return DefaultConfig.transverseDopplerShift(f0, v)
+}
+
+func maxwellBoltzmannCDF(v, T, mass float64) float64 {
+	return DefaultConfig.maxwellBoltzmannCDF(v, T, mass)
+}
+
+func accretionLuminosity(M, mdot, r float64) float64 {
+	return DefaultConfig.accretionLuminosity(M, mdot, r)
+}
+
+func eddingtonLuminosity(M float64) float64 {
+	return DefaultConfig.eddingtonLuminosity(M)
+}
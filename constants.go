@@ -24,9 +24,13 @@ import (
 //
 var (
 
-	// seconds in a single Earth day
+	// seconds in a single Earth day, i.e. the solar day
 	secondsInADay = 86400.0
 
+	// seconds in a single sidereal Earth day, i.e. one full rotation
+	// relative to the distant stars
+	secondsInASiderealDay = 86164.0905
+
 	// Speed of light in a vaccuum, in m/s
 	c = 299792458.0
 
@@ -36,15 +40,88 @@ var (
 	// Planck constant, in Joule seconds
 	planckConstant = 6.626069934 * math.Pow(10, -34)
 
+	// Reduced Planck constant, in Joule seconds
+	reducedPlanckConstant = planckConstant / (2 * math.Pi)
+
 	// Boltzmann constant, in Joules per Kelvin
 	boltzmannConstantJoules = 1.38064852 * math.Pow(10, -23)
 
 	// Boltzmann constant, in eV per Kelvin
 	boltzmannConstantEv = 8.6173303 * math.Pow(10, -5)
 
+	// Stefan-Boltzmann constant, in Watts per square metre per Kelvin^4
+	stefanBoltzmannConstant = 5.670374419 * math.Pow(10, -8)
+
+	// Molar gas constant, in Joules per mole Kelvin
+	molarGasConstant = 8.314462618
+
 	// Vacuum permittivity, in Farads per metre
 	vacuumPermittivity = 8.854187817 * math.Pow(10, -12)
 
+	// Vacuum permeability, in Henries per metre
+	vacuumPermeability = 1.25663706212 * math.Pow(10, -6)
+
+	// Elementary charge, in Coulombs
+	elementaryCharge = 1.602176634 * math.Pow(10, -19)
+
+	// Mass of the electron, in kilograms
+	electronMass = 9.1093837015 * math.Pow(10, -31)
+
+	// Mass of the proton, in kilograms
+	protonMass = 1.67262192369 * math.Pow(10, -27)
+
+	// Thomson scattering cross-section of the electron, in square metres
+	thomsonCrossSection = 6.6524587321 * math.Pow(10, -29)
+
+	// Fine-structure constant, dimensionless
+	fineStructureConstant = 7.2973525693 * math.Pow(10, -3)
+
 	// Mass of the planet Earth, in kilograms
 	massOfTheEarth = 5.97237 * math.Pow(10, 24)
+
+	// Mean radius of the planet Earth, in metres
+	radiusOfTheEarth = 6.371 * math.Pow(10, 6)
+
+	// Mass of the Sun, in kilograms
+	massOfTheSun = 1.98847 * math.Pow(10, 30)
+
+	// Nominal radius of the Sun, in metres
+	radiusOfTheSun = 6.957 * math.Pow(10, 8)
+
+	// Nominal luminosity of the Sun, in Watts
+	solarLuminosity = 3.828 * math.Pow(10, 26)
+
+	// Astronomical unit, i.e. mean Earth-Sun distance, in metres
+	astronomicalUnit = 1.495978707 * math.Pow(10, 11)
+
+	// Mass of the Moon, in kilograms
+	massOfTheMoon = 7.342 * math.Pow(10, 22)
+
+	// Mean radius of the Moon, in metres
+	radiusOfTheMoon = 1.7374 * math.Pow(10, 6)
+
+	// Mean distance between the Earth and the Moon, in metres
+	earthMoonDistance = 3.84399 * math.Pow(10, 8)
+
+	// Acceleration scale of modified Newtonian dynamics, in m/s^2
+	mondAcceleration = 1.2 * math.Pow(10, -10)
+
+	// Planck mass, in kilograms
+	planckMass float64
+
+	// Planck temperature, in Kelvins
+	planckTemperature float64
 )
+
+//
+// Derived constants
+//
+func init() {
+
+	// natural unit of mass, built from hbar, c and G
+	planckMass = math.Sqrt(reducedPlanckConstant * c /
+		universalGravitationConstant)
+
+	// temperature whose thermal energy equals the Planck mass-energy
+	planckTemperature = planckMass * c * c / boltzmannConstantJoules
+}
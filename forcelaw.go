@@ -0,0 +1,92 @@
+/*
+ * Goplex Force Laws
+ *
+ * Description: Interchangeable laws of attraction between two masses, so
+ *              that the n-body functions can be run under alternatives to
+ *              Newtonian gravity, e.g. via nBodyAccelerationsWithLaw.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"math"
+)
+
+// ForceLaw gives the magnitude of the attractive force between two masses
+// at a given separation.
+type ForceLaw interface {
+	Force(m1, m2, r float64) float64
+}
+
+// NewtonianGravity is the inverse-square law of universal gravitation.
+type NewtonianGravity struct{}
+
+//! Attractive force between two masses, as per gravitationalForce
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ *
+ * @result   float64    attractive force, in Newtons
+ */
+func (NewtonianGravity) Force(m1, m2, r float64) float64 {
+	return gravitationalForce(m1, m2, r)
+}
+
+// MOND is modified Newtonian dynamics, which strengthens gravity at
+// accelerations well below A0, e.g. in the outskirts of galaxies, via the
+// standard interpolation function mu(x) = x / sqrt(1 + x^2).
+type MOND struct {
+
+	// acceleration scale below which gravity departs from Newton's, in
+	// m/s^2; zero gives Newtonian gravity
+	A0 float64
+}
+
+//! Attractive force on the first mass due to the second, found by solving
+//! a mu(a / A0) = aN for the acceleration a, given the Newtonian one aN
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ *
+ * @result   float64    attractive force, in Newtons
+ */
+func (law MOND) Force(m1, m2, r float64) float64 {
+
+	newtonian := gravitationalForce(1.0, m2, r)
+
+	// input validation
+	if newtonian == 0.0 || law.A0 == 0.0 {
+		return m1 * newtonian
+	}
+
+	// closed form of the solution for the standard interpolation function
+	ratio := 2 * law.A0 / newtonian
+	acceleration := newtonian * math.Sqrt((1+math.Sqrt(1+ratio*ratio))/2)
+
+	return m1 * acceleration
+}
+
+// ForceLawFunc adapts an ordinary function into a ForceLaw.
+type ForceLawFunc func(m1, m2, r float64) float64
+
+//! Attractive force between two masses, as given by the function itself
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ *
+ * @result   float64    attractive force, in Newtons
+ */
+func (f ForceLawFunc) Force(m1, m2, r float64) float64 {
+	return f(m1, m2, r)
+}
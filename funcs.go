@@ -55,16 +55,18 @@ func tsiolkovskyDeltaV(Ve float64, m0 float64, mf float64) float64 {
  *
  * @result   float64    energy of a photon, in Joules
  */
-func photonEnergy(l float64) float64 {
+func (cfg *Config) photonEnergy(l float64) float64 {
+
+	// compare the wavelength to the planck constant w/ speed of light
+	// and then obtain the ratio of that to the wavelength
+	energy, ok := safeDiv(cfg.PlanckConstant*cfg.C, l)
 
 	// if wavelength is zero, return zero
-	if l == 0 {
+	if !ok {
 		return 0
 	}
 
-	// compare the wavelength to the planck constant w/ speed of light
-	// and then obtain the ratio of that to the wavelength
-	return planckConstant * c / l
+	return energy
 }
 
 //! Thermal velocity of a heated gas
@@ -75,7 +77,7 @@ func photonEnergy(l float64) float64 {
  *
  * @result   float64    velocity of the gas in question
  */
-func thermalVelocityOfHeatedGas(g float64, T float64,
+func (cfg *Config) thermalVelocityOfHeatedGas(g float64, T float64,
 	m float64) float64 {
 
 	// input validation
@@ -87,7 +89,7 @@ func thermalVelocityOfHeatedGas(g float64, T float64,
 	inverseG := 1 / g
 
 	// ratio of the boltzmann & temperature to the molecular mass
-	boltzRatioToMass := 3 * boltzmannConstantEv * T / m
+	boltzRatioToMass := 3 * cfg.BoltzmannConstantEv * T / m
 
 	// since this deals with fluid dynamics in space, take the square of
 	// the boltz-mass ratio
@@ -106,15 +108,16 @@ func thermalVelocityOfHeatedGas(g float64, T float64,
  *
  * @result   float64    time dilation ratio
  */
-func lorentzFactor(v float64) float64 {
+func (cfg *Config) lorentzFactor(v float64) float64 {
 
-	// ensure that the velocity is not equal to c
-	if v == c {
+	// ensure that the velocity is less than c, in either direction,
+	// since the factor is undefined at or beyond it
+	if v >= cfg.C || v <= -cfg.C {
 		return 0.0
 	}
 
 	// determine the square factor
-	squareFactor := 1 - ((v * v) / (c * c))
+	squareFactor := 1 - ((v * v) / (cfg.C * cfg.C))
 
 	// take the square root of the factor
 	sqrtFactor := math.Sqrt(squareFactor)
@@ -136,7 +139,8 @@ func lorentzFactor(v float64) float64 {
  *
  * @result   float64    time dilation ratio
  */
-func abrahamLorentzForce(q float64, e0 float64, a float64) float64 {
+func (cfg *Config) abrahamLorentzForce(q float64, e0 float64,
+	a float64) float64 {
 
 	// ensure that the electrical constant isn't zero
 	if e0 == 0.0 {
@@ -147,7 +151,7 @@ func abrahamLorentzForce(q float64, e0 float64, a float64) float64 {
 	squareOfCharge := q * q
 
 	// calculate the charged field value, for a vacuum
-	chargedFieldValue := 6 * math.Pi * e0 * c * c * c
+	chargedFieldValue := 6 * math.Pi * e0 * cfg.C * cfg.C * cfg.C
 
 	// calculate the ratio of the charge to the value of the field
 	ratioOfChargeToField := squareOfCharge / chargedFieldValue
@@ -164,10 +168,10 @@ func abrahamLorentzForce(q float64, e0 float64, a float64) float64 {
  *
  * @result   float64    perihelion shift, in radians/revolution
  */
-func perihelionShift(L float64, T float64, e float64) float64 {
+func (cfg *Config) perihelionShift(L float64, T float64, e float64) float64 {
 
 	// speed of light in kilometers per second
-	cInKmPerSecond := c * 1000.0
+	cInKmPerSecond := cfg.C * 1000.0
 
 	// calculate the spherical shape of the semi-major axis
 	dividend := 24 * math.Pi * math.Pi * math.Pi * L * L
@@ -175,13 +179,15 @@ func perihelionShift(L float64, T float64, e float64) float64 {
 	// calculate the velocity of the orbital body
 	divisor := T * T * cInKmPerSecond * cInKmPerSecond * (1 - e*e)
 
+	// combine both to obtain the perihelion shift
+	shift, ok := safeDiv(dividend, divisor)
+
 	// safety check, if the divisor is zero, return 0
-	if divisor == 0.0 {
+	if !ok {
 		return 0.0
 	}
 
-	// combine both to obtain the perihelion shift
-	return dividend / divisor
+	return shift
 }
 
 //! Function to calculate the Schwarzschild radius for a given mass,
@@ -191,7 +197,7 @@ func perihelionShift(L float64, T float64, e float64) float64 {
  *
  * @result   float64    Schwarzschild radius, in units
  */
-func schwarzschildRadius(M float64) float64 {
+func (cfg *Config) schwarzschildRadius(M float64) float64 {
 
 	// input validation
 	if M <= 0.0 {
@@ -199,11 +205,1510 @@ func schwarzschildRadius(M float64) float64 {
 	}
 
 	// speed of light in a vacuum, squared
-	speedOfLightInVacSquared := c * c
+	speedOfLightInVacSquared := cfg.C * cfg.C
 
 	// ratio of mass to gravity, as per the universal constant
-	ratioOfMassToGravity := 2 * universalGravitationConstant * M
+	ratioOfMassToGravity := 2 * cfg.G * M
+
+	// pass back the calcuated value, or 0 for a set of constants that
+	// lacks a speed of light
+	radius, ok := safeDiv(ratioOfMassToGravity, speedOfLightInVacSquared)
+	if !ok {
+		return 0.0
+	}
+
+	return radius
+}
+
+//! Function to calculate the gravitational acceleration at a given distance
+//! from the centre of a uniform sphere, either inside or outside of it.
+/*
+ * @param    float64    mass of the sphere           --> M
+ * @param    float64    radius of the sphere         --> R
+ * @param    float64    distance from the centre     --> r
+ *
+ * @result   float64    gravitational acceleration, in m/s^2
+ */
+func (cfg *Config) gravityAtDepth(M float64, R float64, r float64) float64 {
+
+	// input validation
+	if R == 0.0 {
+		return 0.0
+	}
+
+	// outside of the sphere the mass acts as a point mass
+	if r > R {
+		return cfg.G * M / (r * r)
+	}
+
+	// inside of a uniform sphere only the enclosed mass contributes, so
+	// the acceleration falls off linearly towards the centre
+	return cfg.G * M * r / (R * R * R)
+}
+
+//! Function to calculate the tidal acceleration, i.e. the difference in
+//! gravity between two points separated along the line to a mass.
+/*
+ * @param    float64    mass of the attracting body            --> M
+ * @param    float64    distance to the attracting body        --> r
+ * @param    float64    separation between the two points      --> dr
+ *
+ * @result   float64    tidal acceleration, in m/s^2
+ */
+func (cfg *Config) tidalAcceleration(M float64, r float64,
+	dr float64) float64 {
+
+	// input validation
+	if r == 0.0 {
+		return 0.0
+	}
+
+	// the differential gravity falls off with the cube of the distance
+	return 2 * cfg.G * M * dr / (r * r * r)
+}
+
+//! Function to calculate the gravitational time dilation of a clock held
+//! at rest at a given distance from a mass.
+/*
+ * @param    float64    mass of the attracting body    --> M
+ * @param    float64    distance from its centre       --> r
+ *
+ * @result   float64    ratio of the local clock rate to a distant clock
+ */
+func (cfg *Config) gravitationalTimeDilation(M float64, r float64) float64 {
+
+	// obtain the event horizon of the mass in question
+	rs := cfg.schwarzschildRadius(M)
+
+	// input validation, a clock at or within the horizon has no rate
+	if r <= rs {
+		return 0.0
+	}
+
+	// go ahead and return the square root of the metric term
+	return math.Sqrt(1 - rs/r)
+}
+
+//! Function to calculate the net rate of an orbiting clock, combining
+//! the gravitational and velocity time dilation, e.g. for GPS corrections.
+/*
+ * @param    float64    mass of the attracting body    --> M
+ * @param    float64    distance from its centre       --> r
+ * @param    float64    orbital speed                  --> v
+ *
+ * @result   float64    ratio of the clock rate to a distant clock at rest
+ */
+func (cfg *Config) netClockRate(M float64, r float64, v float64) float64 {
+
+	// determine the lorentz factor of the orbital speed
+	gamma := cfg.lorentzFactor(v)
+
+	// safety check, ensure that the factor is not zero
+	if gamma == 0.0 {
+		return 0.0
+	}
+
+	// altitude speeds the clock up, while velocity slows it down
+	return cfg.gravitationalTimeDilation(M, r) / gamma
+}
+
+//! Function to calculate the Jeans mass, i.e. the minimum mass a cloud
+//! of gas requires in order to collapse under its own gravity.
+/*
+ * @param    float64    temperature, in Kelvins          --> T
+ * @param    float64    density of the cloud, in kg/m^3  --> density
+ * @param    float64    mass of a gas particle, in kg    --> meanMolecularMass
+ *
+ * @result   float64    Jeans mass, in kilograms
+ */
+func (cfg *Config) jeansMass(T float64, density float64,
+	meanMolecularMass float64) float64 {
+
+	// input validation
+	if density <= 0.0 || meanMolecularMass <= 0.0 {
+		return 0.0
+	}
+
+	// ratio of the thermal energy to the gravitational binding
+	thermalToGravity := 5 * cfg.BoltzmannConstantJoules * T /
+		(cfg.G * meanMolecularMass)
+
+	// volume term of a sphere of the given density
+	volumeTerm := 3 / (4 * math.Pi * density)
+
+	// combine both to obtain the Jeans mass
+	return math.Pow(thermalToGravity, 1.5) * math.Sqrt(volumeTerm)
+}
+
+//! Function to calculate the free-fall time of a uniform cloud of gas,
+//! i.e. how long it would take to collapse without any pressure support.
+/*
+ * @param    float64    density of the cloud, in kg/m^3  --> density
+ *
+ * @result   float64    free-fall time, in seconds
+ */
+func (cfg *Config) freeFallTime(density float64) float64 {
+
+	// input validation
+	if density <= 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the collapse time
+	return math.Sqrt(3 * math.Pi /
+		(32 * cfg.G * density))
+}
+
+//! Function to calculate the reduced mass of a two-body system
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ *
+ * @result   float64    reduced mass, in kilograms
+ */
+func reducedMass(m1 float64, m2 float64) float64 {
+
+	// input validation
+	if m1+m2 == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the ratio of the product to the sum
+	return m1 * m2 / (m1 + m2)
+}
+
+//! Function to calculate the distance from the first body of a two-body
+//! system to the barycenter, i.e. the centre of mass they both orbit.
+/*
+ * @param    float64    mass of the first body          --> m1
+ * @param    float64    mass of the second body         --> m2
+ * @param    float64    separation between the bodies   --> separation
+ *
+ * @result   float64    distance from the first body to the barycenter
+ */
+func barycenterDistance(m1, m2, separation float64) float64 {
+
+	// input validation
+	if m1+m2 == 0.0 {
+		return 0.0
+	}
+
+	// the barycenter sits closer to the heavier of the two bodies
+	return m2 / (m1 + m2) * separation
+}
+
+//! Function to calculate the rapidity of a given velocity, which unlike
+//! the velocity itself adds linearly across successive boosts.
+/*
+ * @param    float64    velocity --> v
+ *
+ * @result   float64    rapidity, dimensionless
+ */
+func (cfg *Config) rapidityFromVelocity(v float64) float64 {
+
+	// ensure that the velocity is less than c
+	if v >= cfg.C || v <= -cfg.C {
+		return 0.0
+	}
+
+	// go ahead and return the inverse hyperbolic tangent of beta
+	return math.Atanh(v / cfg.C)
+}
+
+//! Function to calculate the velocity that corresponds to a rapidity
+/*
+ * @param    float64    rapidity --> phi
+ *
+ * @result   float64    velocity, in m/s
+ */
+func (cfg *Config) velocityFromRapidity(phi float64) float64 {
+	return cfg.C * math.Tanh(phi)
+}
+
+//! Function to calculate the Larmor power, i.e. the power radiated away
+//! by an accelerating charge in a vacuum.
+/*
+ * @param    float64    charge, in Coulombs    --> q
+ * @param    float64    acceleration           --> a
+ *
+ * @result   float64    radiated power, in Watts
+ */
+func (cfg *Config) larmorPower(q float64, a float64) float64 {
+
+	// calculate the charged field value, for a vacuum
+	chargedFieldValue := 6 * math.Pi * cfg.VacuumPermittivity *
+		cfg.C * cfg.C * cfg.C
+
+	// ensure that the field value isn't zero
+	if chargedFieldValue == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the ratio of the squares to the field
+	return q * q * a * a / chargedFieldValue
+}
+
+//! Function to calculate the cyclotron frequency of a charged particle
+//! moving through a uniform magnetic field.
+/*
+ * @param    float64    charge, in Coulombs            --> q
+ * @param    float64    magnetic field, in Teslas      --> B
+ * @param    float64    mass of the particle, in kg    --> m
+ *
+ * @result   float64    angular frequency, in radians per second
+ */
+func cyclotronFrequency(q, B, m float64) float64 {
+
+	// input validation
+	if m == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the ratio of the magnetic force to the mass
+	return q * B / m
+}
+
+//! Function to calculate the electromagnetic Lorentz force on a charge,
+//! for the case where the field terms act along a single axis.
+/*
+ * @param    float64    charge, in Coulombs                 --> q
+ * @param    float64    electric field, in Volts per metre  --> E
+ * @param    float64    velocity of the charge              --> v
+ * @param    float64    magnetic field, in Teslas           --> B
+ *
+ * @result   float64    force on the charge, in Newtons
+ */
+func lorentzForceEM(q float64, E float64, v float64, B float64) float64 {
+	return q * (E + v*B)
+}
+
+//! Function to calculate the radius of the photon sphere of a black hole,
+//! i.e. the radius at which gravity bends light into a circular orbit.
+/*
+ * @param    float64    mass --> M
+ *
+ * @result   float64    photon sphere radius, in metres
+ */
+func (cfg *Config) photonSphereRadius(M float64) float64 {
+
+	// input validation
+	if M <= 0.0 {
+		return 0.0
+	}
+
+	// the unstable light orbit sits at 3GM/c^2, half again as far out
+	// as the event horizon
+	return 1.5 * cfg.schwarzschildRadius(M)
+}
+
+//! Function to calculate the innermost stable circular orbit (ISCO) of a
+//! non-rotating black hole, within which matter plunges into the horizon.
+/*
+ * @param    float64    mass --> M
+ *
+ * @result   float64    ISCO radius, in metres
+ */
+func (cfg *Config) iscoRadius(M float64) float64 {
+
+	// input validation
+	if M <= 0.0 {
+		return 0.0
+	}
+
+	// the last stable orbit sits at 6GM/c^2, thrice the event horizon
+	return 3 * cfg.schwarzschildRadius(M)
+}
+
+//! Function to calculate the surface gravity of a spherical body
+/*
+ * @param    float64    mass of the body      --> M
+ * @param    float64    radius of the body    --> r
+ *
+ * @result   float64    gravitational acceleration at the surface, in m/s^2
+ */
+func (cfg *Config) surfaceGravity(M float64, r float64) float64 {
+
+	// input validation
+	if r == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the inverse-square acceleration
+	return cfg.G * M / (r * r)
+}
+
+//! Function to calculate the Saha ionization ratio, i.e. the ratio of
+//! ionized to neutral atoms of a gas in thermal equilibrium. The weights
+//! of the two states are assumed to cancel the spin of the electron, as
+//! they do for hydrogen.
+/*
+ * @param    float64    temperature, in Kelvins       --> T
+ * @param    float64    electron density, per m^3     --> electronDensity
+ * @param    float64    ionization energy, in Joules  --> ionizationEnergy
+ *
+ * @result   float64    ratio of ionized to neutral atoms
+ */
+func (cfg *Config) sahaRatio(T, electronDensity,
+	ionizationEnergy float64) float64 {
+
+	// input validation
+	if T <= 0.0 || electronDensity <= 0.0 {
+		return 0.0
+	}
+
+	// thermal energy of the gas
+	kT := cfg.BoltzmannConstantJoules * T
+
+	// thermal de Broglie wavelength of the electrons
+	wavelength := cfg.PlanckConstant / math.Sqrt(2*math.Pi*electronMass*kT)
+
+	// number of electron states available per unit volume
+	quantumDensity := 1 / (wavelength * wavelength * wavelength)
+
+	// weigh those states by the Boltzmann factor of the ionization energy
+	return quantumDensity / electronDensity *
+		math.Exp(-ionizationEnergy/kT)
+}
+
+//! Function to calculate the photon flux received from a light source,
+//! assuming all of its light is emitted at a single wavelength.
+/*
+ * @param    float64    luminosity of the source, in Watts    --> luminosity
+ * @param    float64    wavelength of the light               --> wavelength
+ * @param    float64    distance from the source              --> distance
+ *
+ * @result   float64    photons per square metre per second
+ */
+func (cfg *Config) photonFlux(luminosity float64, wavelength float64,
+	distance float64) float64 {
+
+	// input validation
+	if distance <= 0.0 {
+		return 0.0
+	}
+
+	// energy of each individual photon
+	energyPerPhoton := cfg.photonEnergy(wavelength)
+
+	// safety check, ensure that the photon energy is not zero
+	if energyPerPhoton == 0.0 {
+		return 0.0
+	}
+
+	// spread the luminosity over a sphere of the given distance
+	energyFlux := luminosity / (4 * math.Pi * distance * distance)
+
+	// go ahead and return the number of photons in that energy
+	return energyFlux / energyPerPhoton
+}
+
+//! Function to calculate the relativistic (apparent) mass of a moving body.
+//! Note that modern physics treats mass as invariant and instead lets the
+//! energy and momentum grow with the lorentz factor; this is the older
+//! convention still found in some teaching material.
+/*
+ * @param    float64    rest mass    --> m0
+ * @param    float64    velocity     --> v
+ *
+ * @result   float64    relativistic mass, in the units of the rest mass
+ */
+func (cfg *Config) relativisticMass(m0 float64, v float64) float64 {
+
+	// ensure that the velocity is less than c
+	if v >= cfg.C || v <= -cfg.C {
+		return 0.0
+	}
+
+	// go ahead and scale the rest mass by the lorentz factor
+	return cfg.lorentzFactor(v) * m0
+}
+
+//! Function to calculate the scale height of an atmosphere, i.e. the
+//! altitude over which its pressure falls by a factor of e.
+/*
+ * @param    float64    temperature, in Kelvins            --> T
+ * @param    float64    molar mass of the gas, in kg/mol   --> molarMass
+ * @param    float64    gravitational acceleration         --> g
+ *
+ * @result   float64    scale height, in metres
+ */
+func scaleHeight(T, molarMass, g float64) float64 {
+
+	// input validation
+	if g == 0.0 || molarMass == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the ratio of thermal to gravitational energy
+	return molarGasConstant * T / (molarMass * g)
+}
+
+//! Function to calculate the pressure of an isothermal atmosphere at a
+//! given altitude, via the barometric formula.
+/*
+ * @param    float64    pressure at zero altitude         --> p0
+ * @param    float64    altitude, in metres               --> altitude
+ * @param    float64    scale height of the atmosphere    --> scaleHeight
+ *
+ * @result   float64    pressure, in the units of p0
+ */
+func barometricPressure(p0, altitude, scaleHeight float64) float64 {
+
+	// input validation, without a scale height the pressure stays level
+	if scaleHeight == 0.0 {
+		return p0
+	}
+
+	// go ahead and return the exponentially decayed pressure
+	return p0 * math.Exp(-altitude/scaleHeight)
+}
+
+//! Function to calculate the gravitational force between two masses
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ *
+ * @result   float64    attractive force, in Newtons
+ */
+func (cfg *Config) gravitationalForce(m1, m2, r float64) float64 {
+
+	// input validation
+	if r == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the inverse-square force
+	return cfg.G * m1 * m2 / (r * r)
+}
+
+//! Function to calculate the gravitational force between two masses with
+//! a softening length, which keeps the force finite as the separation
+//! approaches zero, e.g. for n-body simulations.
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ * @param    float64    softening length           --> softening
+ *
+ * @result   float64    attractive force, in Newtons
+ */
+func (cfg *Config) gravitationalForceSoftened(m1, m2, r,
+	softening float64) float64 {
+
+	// softened square of the separation
+	softenedSquare := r*r + softening*softening
+
+	// input validation
+	if softenedSquare == 0.0 {
+		return 0.0
+	}
+
+	// go ahead and return the softened inverse-square force
+	return cfg.G * m1 * m2 / softenedSquare
+}
+
+//! Function to calculate the gravitational potential energy of two masses
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ *
+ * @result   float64    potential energy, in Joules
+ */
+func (cfg *Config) gravitationalPotentialEnergy(m1, m2, r float64) float64 {
+
+	// input validation
+	if r == 0.0 {
+		return 0.0
+	}
+
+	// the energy is negative, since the masses are bound to one another
+	return -cfg.G * m1 * m2 / r
+}
+
+//! Function to calculate the Einstein radius of a gravitational lens,
+//! i.e. the angular radius of the ring formed by a perfectly aligned
+//! background source.
+/*
+ * @param    float64    mass of the lens                 --> M
+ * @param    float64    distance to the lens             --> dL
+ * @param    float64    distance to the source           --> dS
+ * @param    float64    distance from lens to source     --> dLS
+ *
+ * @result   float64    Einstein radius, in radians
+ */
+func (cfg *Config) einsteinRadius(M, dL, dS, dLS float64) float64 {
+
+	// input validation
+	if dL <= 0.0 || dS <= 0.0 || dLS <= 0.0 {
+		return 0.0
+	}
+
+	// deflection scale of the lens mass
+	deflectionScale := 4 * cfg.G * M / (cfg.C * cfg.C)
+
+	// go ahead and weigh it by the geometry of the lens and source
+	return math.Sqrt(deflectionScale * dLS / (dL * dS))
+}
+
+//! Function to calculate the gravitational acceleration along the axis
+//! of a thin ring of mass.
+/*
+ * @param    float64    mass of the ring                  --> M
+ * @param    float64    radius of the ring                --> ringRadius
+ * @param    float64    distance along the axis           --> axialDistance
+ *
+ * @result   float64    gravitational acceleration, in m/s^2
+ */
+func (cfg *Config) gravityFromRing(M, ringRadius,
+	axialDistance float64) float64 {
+
+	// distance from the point on the axis to the ring itself
+	distanceSquared := axialDistance*axialDistance + ringRadius*ringRadius
+
+	// input validation
+	if distanceSquared == 0.0 {
+		return 0.0
+	}
+
+	// only the axial component of the pull survives the symmetry
+	distance := math.Sqrt(distanceSquared)
+	return cfg.G * M * axialDistance /
+		(distanceSquared * distance)
+}
+
+//! Function to calculate the relativistic Doppler factor of a source,
+//! i.e. the ratio of observed to emitted frequency.
+/*
+ * @param    float64    angle between velocity and line of sight --> theta
+ * @param    float64    velocity of the source                   --> v
+ *
+ * @result   float64    Doppler factor, dimensionless
+ */
+func (cfg *Config) dopplerFactor(theta float64, v float64) float64 {
+
+	// ensure that the velocity is less than c
+	if v >= cfg.C || v <= -cfg.C {
+		return 0.0
+	}
+
+	// determine the divisor from the lorentz factor and the component
+	// of the velocity along the line of sight
+	divisor := cfg.lorentzFactor(v) * (1 - v/cfg.C*math.Cos(theta))
+
+	// safety check, ensure that the divisor is not zero
+	if divisor == 0.0 {
+		return 0.0
+	}
+
+	return 1 / divisor
+}
+
+//! Function to calculate the energy of a photon in electron-volts
+/*
+ * @param    float64    wavelength --> l
+ *
+ * @result   float64    energy of a photon, in eV
+ */
+func (cfg *Config) photonEnergyEv(l float64) float64 {
+	return cfg.photonEnergy(l) / elementaryCharge
+}
+
+//! Function to calculate the gravitational redshift of light emitted at a
+//! given distance from a mass and received far away.
+/*
+ * @param    float64    mass of the attracting body    --> M
+ * @param    float64    distance of the emitter        --> r
+ *
+ * @result   float64    redshift z, dimensionless
+ */
+func (cfg *Config) gravitationalRedshift(M float64, r float64) float64 {
+
+	// a clock deeper in the potential ticks slower
+	rate := cfg.gravitationalTimeDilation(M, r)
+
+	// input validation, light from within the horizon never escapes
+	if rate == 0.0 {
+		return 0.0
+	}
+
+	return 1/rate - 1
+}
+
+//! Function to calculate the observed wavelength of a spectral line that
+//! was emitted at a given distance from a mass.
+/*
+ * @param    float64    emitted wavelength             --> l0
+ * @param    float64    mass of the attracting body    --> M
+ * @param    float64    distance of the emitter        --> r
+ *
+ * @result   float64    observed wavelength, in the units of l0
+ */
+func (cfg *Config) redshiftedWavelength(l0, M, r float64) float64 {
+
+	// input validation
+	if r <= cfg.schwarzschildRadius(M) {
+		return 0.0
+	}
+
+	return l0 * (1 + cfg.gravitationalRedshift(M, r))
+}
+
+//! Function to calculate the Schwarzschild radius of a catalog of masses
+/*
+ * @param    []float64    masses --> masses
+ *
+ * @result   []float64    Schwarzschild radius of each mass, in metres
+ */
+func (cfg *Config) schwarzschildRadii(masses []float64) []float64 {
+
+	radii := make([]float64, len(masses))
+
+	// invalid masses map to zero, as they do for a single mass
+	for i, M := range masses {
+		radii[i] = cfg.schwarzschildRadius(M)
+	}
+
+	return radii
+}
+
+//! Function to calculate the total energy of a particle from its mass and
+//! momentum, via the relativistic energy-momentum relation.
+/*
+ * @param    float64    rest mass, in kilograms    --> m
+ * @param    float64    momentum, in kg m/s        --> p
+ *
+ * @result   float64    total energy, in Joules
+ */
+func (cfg *Config) energyMomentumRelation(m, p float64) float64 {
+
+	// momentum and rest energy add in quadrature
+	return math.Hypot(p*cfg.C, m*cfg.C*cfg.C)
+}
+
+//! Function to estimate the gravitational wave strain of a circular binary
+//! as seen by a distant observer, to within an order of magnitude.
+/*
+ * @param    float64    mass of the first body          --> m1
+ * @param    float64    mass of the second body         --> m2
+ * @param    float64    separation of the bodies        --> r
+ * @param    float64    distance to the observer        --> distance
+ *
+ * @result   float64    strain amplitude h, dimensionless
+ */
+func (cfg *Config) gravitationalWaveStrain(m1, m2, r,
+	distance float64) float64 {
+
+	// input validation
+	if distance <= 0.0 || r <= 0.0 {
+		return 0.0
+	}
+
+	// quadrupole estimate, h ~ 4 G^2 m1 m2 / (c^4 r D)
+	G := cfg.G
+	return 4 * G * G * m1 * m2 / (cfg.C * cfg.C * cfg.C * cfg.C * r * distance)
+}
+
+//! Function to calculate the change in velocity from an impulsive burn
+/*
+ * @param    float64    impulse, in Newton seconds    --> impulse
+ * @param    float64    mass of the vehicle, in kg    --> mass
+ *
+ * @result   float64    delta-v, in m/s
+ */
+func deltaVFromImpulse(impulse float64, mass float64) float64 {
+
+	// input validation
+	if mass == 0.0 {
+		return 0.0
+	}
+
+	return impulse / mass
+}
+
+//! Function to calculate the impulse needed for a given change in velocity
+/*
+ * @param    float64    delta-v, in m/s               --> deltaV
+ * @param    float64    mass of the vehicle, in kg    --> mass
+ *
+ * @result   float64    impulse, in Newton seconds
+ */
+func impulseForDeltaV(deltaV, mass float64) float64 {
+
+	// input validation
+	if mass == 0.0 {
+		return 0.0
+	}
+
+	return deltaV * mass
+}
+
+//! Function to calculate the thrust of a rocket engine
+/*
+ * @param    float64    mass flow rate, in kg/s          --> massFlowRate
+ * @param    float64    effective exhaust velocity       --> exhaustVelocity
+ *
+ * @result   float64    thrust, in Newtons
+ */
+func thrust(massFlowRate float64, exhaustVelocity float64) float64 {
+	return massFlowRate * exhaustVelocity
+}
+
+//! Function to calculate how long an engine burns through its propellant
+/*
+ * @param    float64    mass of propellant, in kg        --> propellantMass
+ * @param    float64    mass flow rate, in kg/s          --> massFlowRate
+ *
+ * @result   float64    burn time, in seconds
+ */
+func burnTime(propellantMass, massFlowRate float64) float64 {
+
+	// input validation
+	if massFlowRate == 0.0 {
+		return 0.0
+	}
+
+	return propellantMass / massFlowRate
+}
+
+//! Function to calculate the relativistic precession of the perihelion
+//! of an orbit, from the mass of the body orbited, i.e. 6 pi G M /
+//! (c^2 a (1 - e^2)). In principle perihelionShift gives the same result
+//! from the orbital period instead, via Kepler's third law, GM = 4 pi^2
+//! a^3 / T^2, but the two do not agree: its second parameter is labelled
+//! as the orbital speed rather than the period, and it converts c to km/s
+//! by multiplying by 1000 rather than dividing, so that given the period
+//! of Mercury it comes out 1e12 times too small.
+/*
+ * @param    float64    mass of the body orbited     --> M
+ * @param    float64    semi-major axis, in metres   --> a
+ * @param    float64    orbital eccentricity         --> e
+ *
+ * @result   float64    perihelion shift, in radians/revolution
+ */
+func (cfg *Config) relativisticPerihelionPrecession(M, a, e float64) float64 {
+
+	// calculate the size of the orbit, corrected for its eccentricity
+	divisor := cfg.C * cfg.C * a * (1 - e*e)
+
+	// safety check, if the divisor is zero, return 0
+	if divisor == 0.0 {
+		return 0.0
+	}
+
+	return 6 * math.Pi * cfg.G * M / divisor
+}
+
+//! Function to calculate the capture cross-section of a body, i.e. its
+//! geometric cross-section enlarged by gravitational focusing.
+/*
+ * @param    float64    mass of the body                   --> M
+ * @param    float64    radius of the body                 --> r
+ * @param    float64    relative velocity at infinity      --> vInfinity
+ *
+ * @result   float64    capture cross-section, in m^2
+ */
+func (cfg *Config) captureCrossSection(M, r, vInfinity float64) float64 {
+
+	// input validation
+	if vInfinity == 0.0 || r <= 0.0 {
+		return 0.0
+	}
+
+	// slower particles are focused from further away
+	focusing := 1 + 2*cfg.G*M/
+		(r*vInfinity*vInfinity)
+
+	// go ahead and scale the geometric cross-section
+	return math.Pi * r * r * focusing
+}
+
+//! Function to calculate the length of a solar day from the sidereal
+//! rotation period, since a planet must turn slightly further each day to
+//! face its star again as it moves along its orbit.
+/*
+ * @param    float64    sidereal rotation period --> siderealSeconds
+ * @param    float64    orbital period           --> orbitalPeriodSeconds
+ *
+ * @result   float64    solar day, in seconds
+ */
+func rotationPeriodToSolarDay(siderealSeconds,
+	orbitalPeriodSeconds float64) float64 {
+
+	// determine the difference between the two periods
+	divisor := orbitalPeriodSeconds - siderealSeconds
+
+	// safety check, a tidally locked body never sees its star move
+	if divisor == 0.0 {
+		return 0.0
+	}
+
+	return siderealSeconds * orbitalPeriodSeconds / divisor
+}
+
+//! Function to calculate the internal energy of an ideal gas, via the
+//! equipartition theorem.
+/*
+ * @param    float64    amount of gas, in moles          --> n
+ * @param    float64    temperature, in Kelvins          --> T
+ * @param    int        degrees of freedom per molecule  --> degreesOfFreedom
+ *
+ * @result   float64    internal energy, in Joules
+ */
+func idealGasInternalEnergy(n, T float64, degreesOfFreedom int) float64 {
+
+	// input validation
+	if T < 0.0 {
+		return 0.0
+	}
+
+	// each degree of freedom holds RT/2 of energy per mole
+	return float64(degreesOfFreedom) / 2 * n * molarGasConstant * T
+}
+
+//! Function to calculate the speed of sound in an ideal gas
+/*
+ * @param    float64    adiabatic index of the gas            --> gamma
+ * @param    float64    temperature, in Kelvins               --> T
+ * @param    float64    molar mass of the gas, in kg/mol      --> molarMass
+ *
+ * @result   float64    speed of sound, in m/s
+ */
+func speedOfSound(gamma, T, molarMass float64) float64 {
+
+	// input validation
+	if molarMass <= 0.0 || T < 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(gamma * molarGasConstant * T / molarMass)
+}
+
+//! Function to calculate the Mach number of a given velocity
+/*
+ * @param    float64    velocity                   --> v
+ * @param    float64    local speed of sound       --> speedOfSound
+ *
+ * @result   float64    Mach number, dimensionless
+ */
+func machNumber(v, speedOfSound float64) float64 {
+
+	// input validation
+	if speedOfSound <= 0.0 {
+		return 0.0
+	}
+
+	return v / speedOfSound
+}
+
+//! Function to calculate the escape velocity from the surface of a body
+/*
+ * @param    float64    mass of the body      --> M
+ * @param    float64    radius of the body    --> r
+ *
+ * @result   float64    escape velocity, in m/s
+ */
+func (cfg *Config) escapeVelocity(M float64, r float64) float64 {
+
+	// input validation
+	if M <= 0.0 || r <= 0.0 {
+		return 0.0
+	}
+
+	// kinetic energy must match the depth of the potential well
+	return math.Sqrt(2 * cfg.G * M / r)
+}
+
+//! Function to calculate the velocity of a circular orbit
+/*
+ * @param    float64    mass of the body orbited      --> M
+ * @param    float64    radius of the orbit           --> r
+ *
+ * @result   float64    orbital velocity, in m/s
+ */
+func (cfg *Config) circularOrbitalVelocity(M float64, r float64) float64 {
+
+	// input validation
+	if M <= 0.0 || r <= 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(cfg.G * M / r)
+}
+
+//! Function to calculate the difference in velocity between two circular
+//! orbits, e.g. for planning a rendezvous.
+/*
+ * @param    float64    mass of the body orbited      --> M
+ * @param    float64    radius of the first orbit     --> r1
+ * @param    float64    radius of the second orbit    --> r2
+ *
+ * @result   float64    velocity of the first less that of the second
+ */
+func (cfg *Config) orbitalVelocityDifference(M, r1, r2 float64) float64 {
+	return cfg.circularOrbitalVelocity(M, r1) -
+		cfg.circularOrbitalVelocity(M, r2)
+}
+
+//! Function to calculate the radius of the sphere of influence of a body
+//! orbiting a much heavier primary, within which its own gravity dominates.
+/*
+ * @param    float64    semi-major axis of the orbit      --> a
+ * @param    float64    mass of the orbiting body         --> mSatellite
+ * @param    float64    mass of the primary               --> mPrimary
+ *
+ * @result   float64    sphere of influence radius, in the units of a
+ */
+func sphereOfInfluence(a, mSatellite, mPrimary float64) float64 {
+
+	// input validation
+	if mPrimary == 0.0 {
+		return 0.0
+	}
+
+	return a * math.Pow(mSatellite/mPrimary, 2.0/5.0)
+}
+
+//! Function to calculate the proper acceleration felt by a body, i.e. the
+//! acceleration measured by an accelerometer it carries, from the
+//! coordinate acceleration seen by an observer, for motion along the
+//! direction of the velocity.
+/*
+ * @param    float64    velocity                  --> v
+ * @param    float64    coordinate acceleration   --> coordinateAccel
+ *
+ * @result   float64    proper acceleration
+ */
+func (cfg *Config) properAcceleration(v, coordinateAccel float64) float64 {
+
+	// ensure that the velocity is less than c
+	if v >= cfg.C || v <= -cfg.C {
+		return 0.0
+	}
+
+	gamma := cfg.lorentzFactor(v)
+
+	return gamma * gamma * gamma * coordinateAccel
+}
+
+//! Function to calculate the frequency heard from a moving source of sound
+//! by a moving observer, via the classical Doppler formula. Unlike the
+//! relativistic dopplerFactor, this one needs a medium, so the speeds of
+//! the source and observer are measured relative to it.
+/*
+ * @param    float64    emitted frequency                 --> f0
+ * @param    float64    speed of source towards observer  --> vSource
+ * @param    float64    speed of observer towards source  --> vObserver
+ * @param    float64    speed of sound in the medium      --> soundSpeed
+ *
+ * @result   float64    observed frequency, in the units of f0
+ */
+func classicalDopplerFrequency(f0, vSource, vObserver,
+	soundSpeed float64) float64 {
+
+	divisor := soundSpeed - vSource
+
+	// safety check, ensure that the divisor is not zero
+	if divisor == 0.0 {
+		return 0.0
+	}
+
+	return f0 * (soundSpeed + vObserver) / divisor
+}
+
+//! Function to calculate the chirp mass of a binary, i.e. the combination
+//! of the two masses that sets how quickly the frequency of the emitted
+//! gravitational waves sweeps upwards during the inspiral.
+/*
+ * @param    float64    mass of the first body    --> m1
+ * @param    float64    mass of the second body   --> m2
+ *
+ * @result   float64    chirp mass, in the units of the masses
+ */
+func chirpMass(m1, m2 float64) float64 {
+
+	// input validation
+	if m1+m2 == 0.0 {
+		return 0.0
+	}
+
+	return math.Pow(m1*m2, 3.0/5.0) / math.Pow(m1+m2, 1.0/5.0)
+}
+
+//! Function to approximate the distance of the L1 Lagrange point from the
+//! lighter of two bodies, towards the heavier one, via the cube-root
+//! formula of the Hill sphere; this holds when the secondary is much less
+//! massive than the primary.
+/*
+ * @param    float64    mass of the primary                --> mPrimary
+ * @param    float64    mass of the secondary              --> mSecondary
+ * @param    float64    separation of the two bodies       --> separation
+ *
+ * @result   float64    distance of L1 from the secondary, in the units of
+ *                      the separation
+ */
+func lagrangeL1Distance(mPrimary, mSecondary, separation float64) float64 {
+
+	// input validation
+	if mPrimary == 0.0 {
+		return 0.0
+	}
+
+	return separation * math.Cbrt(mSecondary/(3*mPrimary))
+}
+
+//! Function to calculate the relativistic kinetic energy of a body, i.e.
+//! the energy it has beyond its rest energy of mc^2.
+/*
+ * @param    float64    rest mass    --> m
+ * @param    float64    velocity     --> v
+ *
+ * @result   float64    kinetic energy, in Joules
+ */
+func (cfg *Config) relativisticKineticEnergy(m, v float64) float64 {
+
+	// ensure that the velocity is less than c
+	if v >= cfg.C || v <= -cfg.C {
+		return 0.0
+	}
+
+	return (cfg.lorentzFactor(v) - 1) * m * cfg.C * cfg.C
+}
+
+//! Function to calculate the relativistic kinetic energy of a body in
+//! electron-volts, the unit of choice for particle physics
+/*
+ * @param    float64    rest mass    --> m
+ * @param    float64    velocity     --> v
+ *
+ * @result   float64    kinetic energy, in eV
+ */
+func (cfg *Config) relativisticKineticEnergyEv(m, v float64) float64 {
+	return cfg.relativisticKineticEnergy(m, v) / elementaryCharge
+}
+
+//! Function to calculate the time taken by a body, released at rest, to
+//! fall radially from one distance to another towards a point mass, via
+//! the closed-form solution of the degenerate Kepler orbit.
+/*
+ * @param    float64    mass of the attracting body         --> M
+ * @param    float64    distance the body is released at   --> r0
+ * @param    float64    distance the body falls to          --> rFinal
+ *
+ * @result   float64    time of the fall, in seconds
+ */
+func (cfg *Config) radialFreeFallTime(M, r0, rFinal float64) float64 {
+
+	// input validation, the body can only fall inwards
+	if M <= 0.0 || r0 <= 0.0 || rFinal <= 0.0 || rFinal > r0 {
+		return 0.0
+	}
+
+	x := rFinal / r0
+
+	return math.Sqrt(r0*r0*r0/(2*cfg.G*M)) *
+		(math.Sqrt(x*(1-x)) + math.Acos(math.Sqrt(x)))
+}
+
+//! Function to estimate the fractional difference between the gravity of
+//! general relativity and that of Newton at a distance from a mass, to
+//! first order, i.e. the ratio of the Schwarzschild radius to the distance.
+/*
+ * @param    float64    mass of the attracting body    --> M
+ * @param    float64    distance from the body         --> r
+ *
+ * @result   float64    fractional correction, dimensionless
+ */
+func (cfg *Config) grCorrectionFactor(M, r float64) float64 {
+
+	// input validation
+	if r <= 0.0 {
+		return 0.0
+	}
+
+	return cfg.schwarzschildRadius(M) / r
+}
+
+//! Function to calculate the lorentz factor of a body from its kinetic
+//! energy, i.e. the inverse of relativisticKineticEnergy.
+/*
+ * @param    float64    rest mass                   --> m
+ * @param    float64    kinetic energy, in Joules   --> ke
+ *
+ * @result   float64    lorentz factor, dimensionless
+ */
+func (cfg *Config) lorentzFactorFromKineticEnergy(m, ke float64) float64 {
+
+	// input validation
+	if m == 0.0 {
+		return 0.0
+	}
+
+	return 1 + ke/(m*cfg.C*cfg.C)
+}
+
+//! Function to calculate the relativistic Doppler factor of a source, as
+//! per dopplerFactor, but with the angle given in degrees.
+/*
+ * @param    float64    angle to the line of sight, in degrees  --> thetaDeg
+ * @param    float64    velocity of the source                  --> v
+ *
+ * @result   float64    Doppler factor, dimensionless
+ */
+func (cfg *Config) dopplerFactorDeg(thetaDeg float64, v float64) float64 {
+	return cfg.dopplerFactor(degreesToRadians(thetaDeg), v)
+}
+
+//! Function to calculate the invariant mass of a body or a system of
+//! particles from its total energy and momentum, which is the same in
+//! every frame of reference.
+/*
+ * @param    float64    total energy, in Joules          --> E
+ * @param    Vec3       total momentum, in kg m/s        --> p
+ *
+ * @result   float64    invariant mass, in kilograms
+ */
+func (cfg *Config) invariantMass(E float64, p Vec3) float64 {
+
+	// E^2 / c^2 - |p|^2, i.e. (mc)^2
+	massSquared := E*E/(cfg.C*cfg.C) - p.Dot(p)
+
+	// safety check, a system cannot move faster than light, but rounding
+	// can leave that of a single photon slightly negative
+	if massSquared <= 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(massSquared) / cfg.C
+}
+
+//! Function to calculate the gravitational acceleration at a distance from
+//! the centre of a body made of concentric spherical shells. By the shell
+//! theorem, only the shells inside, or at, that distance pull on it, and
+//! they do so as if all of their mass sat at the centre.
+/*
+ * @param    []float64    masses of the shells, in kg      --> shellMasses
+ * @param    []float64    radii of the shells              --> shellRadii
+ * @param    float64      distance from the centre         --> r
+ *
+ * @result   float64      gravitational acceleration, in m/s^2
+ */
+func (cfg *Config) shellModelGravity(shellMasses []float64,
+	shellRadii []float64, r float64) float64 {
+
+	// input validation
+	if len(shellMasses) != len(shellRadii) || r <= 0.0 {
+		return 0.0
+	}
+
+	// total mass of the shells that lie within, or at, the distance
+	enclosedMass := 0.0
+	for i, radius := range shellRadii {
+		if radius <= r {
+			enclosedMass += shellMasses[i]
+		}
+	}
+
+	return cfg.G * enclosedMass / (r * r)
+}
+
+//! Function to estimate the pressure of a degenerate electron gas, e.g. in
+//! a white dwarf, via the non-relativistic formula; this holds while the
+//! electrons are well below the speed of light, i.e. for densities of
+//! less than ~6e35 electrons per m^3.
+/*
+ * @param    float64    electrons per m^3    --> numberDensity
+ *
+ * @result   float64    degeneracy pressure, in Pascals
+ */
+func (cfg *Config) degeneracyPressure(numberDensity float64) float64 {
+
+	// input validation
+	if numberDensity <= 0.0 {
+		return 0.0
+	}
+
+	// reduced Planck constant, of the given set of constants
+	hbar := cfg.PlanckConstant / (2 * math.Pi)
+
+	return math.Pow(3*math.Pi*math.Pi, 2.0/3.0) / 5 *
+		hbar * hbar / electronMass * math.Pow(numberDensity, 5.0/3.0)
+}
+
+//! Function to calculate the frequency of the gravitational waves from a
+//! binary, which in the dominant quadrupole mode is twice its orbital
+//! frequency.
+/*
+ * @param    float64    orbital frequency    --> orbitalFrequency
+ *
+ * @result   float64    gravitational wave frequency, in the same units
+ */
+func gwFrequency(orbitalFrequency float64) float64 {
+	return 2 * orbitalFrequency
+}
+
+//! Function to calculate the orbital frequency of a binary on a circular
+//! orbit, from the separation of its two bodies, as per Kepler's third law.
+/*
+ * @param    float64    mass of the first body     --> m1
+ * @param    float64    mass of the second body    --> m2
+ * @param    float64    separation of the bodies   --> r
+ *
+ * @result   float64    orbital frequency, in Hz
+ */
+func (cfg *Config) orbitalFrequencyFromSeparation(m1, m2, r float64) float64 {
+
+	// input validation
+	if r <= 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(cfg.G*(m1+m2)/(r*r*r)) /
+		(2 * math.Pi)
+}
+
+//! Function to calculate the angle by which light passing a mass is bent,
+//! in the weak field limit of general relativity.
+/*
+ * @param    float64    mass of the deflecting body              --> M
+ * @param    float64    impact parameter, i.e. closest approach  --> b
+ *
+ * @result   float64    deflection angle, in radians
+ */
+func (cfg *Config) lightDeflectionAngle(M, b float64) float64 {
+
+	// input validation
+	if b == 0.0 {
+		return 0.0
+	}
+
+	return 4 * cfg.G * M / (cfg.C * cfg.C * b)
+}
+
+//! Function to calculate the impact parameter at which light passing a mass
+//! is bent by a given angle, i.e. the inverse of lightDeflectionAngle.
+/*
+ * @param    float64    mass of the deflecting body     --> M
+ * @param    float64    deflection angle, in radians    --> deflectionAngle
+ *
+ * @result   float64    impact parameter, in metres
+ */
+func (cfg *Config) impactParameterForDeflection(M,
+	deflectionAngle float64) float64 {
+
+	// input validation
+	if deflectionAngle == 0.0 {
+		return 0.0
+	}
+
+	return 4 * cfg.G * M / (cfg.C * cfg.C * deflectionAngle)
+}
+
+//! Function to calculate the spectral radiance of a black body at a given
+//! wavelength, as per Planck's law.
+/*
+ * @param    float64    wavelength, in metres      --> l
+ * @param    float64    temperature, in Kelvins    --> T
+ *
+ * @result   float64    spectral radiance, in W / (sr m^3)
+ */
+func (cfg *Config) planckSpectralRadiance(l, T float64) float64 {
+
+	// input validation
+	if l <= 0.0 || T <= 0.0 {
+		return 0.0
+	}
+
+	// ratio of the photon energy to the thermal energy
+	x := cfg.PlanckConstant * cfg.C / (l * cfg.BoltzmannConstantJoules * T)
+
+	return 2 * cfg.PlanckConstant * cfg.C * cfg.C / math.Pow(l, 5) /
+		math.Expm1(x)
+}
+
+//! Function to calculate the radiance of a black body over all wavelengths
+//! by integrating Planck's law numerically; this ought to agree with the
+//! Stefan-Boltzmann law, i.e. sigma T^4 / pi.
+/*
+ * @param    float64    temperature, in Kelvins    --> T
+ *
+ * @result   float64    radiance, in W / (sr m^2)
+ */
+func (cfg *Config) integratedPlanckRadiance(T float64) float64 {
+
+	// input validation
+	if T <= 0.0 {
+		return 0.0
+	}
+
+	// the spectrum spans many decades of wavelength, so integrate over
+	// its logarithm, from where the photon energy is 100 times the thermal
+	// energy to where it is a thousandth of it
+	thermalWavelength := cfg.PlanckConstant * cfg.C /
+		(cfg.BoltzmannConstantJoules * T)
+	radianceOfLog := func(logL float64) float64 {
+		l := math.Exp(logL)
+		return cfg.planckSpectralRadiance(l, T) * l
+	}
+
+	return simpsonIntegrate(radianceOfLog, math.Log(thermalWavelength/100),
+		math.Log(thermalWavelength*1000), 2000)
+}
+
+//! Function to calculate the gravitational acceleration at the surface of
+//! a uniform sphere from its density and radius.
+/*
+ * @param    float64    density, in kg/m^3     --> density
+ * @param    float64    radius of the sphere   --> radius
+ *
+ * @result   float64    gravitational acceleration, in m/s^2
+ */
+func (cfg *Config) surfaceGravityFromDensity(density, radius float64) float64 {
+
+	// input validation
+	if density <= 0.0 || radius <= 0.0 {
+		return 0.0
+	}
+
+	return 4.0 / 3.0 * math.Pi * cfg.G * density *
+		radius
+}
+
+//! Function to calculate the frequency received from a source moving
+//! across the line of sight, at its closest approach. Unlike dopplerFactor
+//! along the line of sight, this shift comes purely from time dilation,
+//! so it is always towards the red.
+/*
+ * @param    float64    emitted frequency         --> f0
+ * @param    float64    velocity of the source    --> v
+ *
+ * @result   float64    observed frequency, in the units of f0
+ */
+func (cfg *Config) transverseDopplerShift(f0, v float64) float64 {
+
+	// ensure that the velocity is less than c
+	if v >= cfg.C || v <= -cfg.C {
+		return 0.0
+	}
+
+	return f0 / cfg.lorentzFactor(v)
+}
+
+//! Function to calculate the fraction of the molecules of a gas in thermal
+//! equilibrium that move slower than a given speed, i.e. the cumulative
+//! Maxwell-Boltzmann distribution of speeds.
+/*
+ * @param    float64    speed, in m/s                      --> v
+ * @param    float64    temperature, in Kelvins            --> T
+ * @param    float64    mass of a molecule, in kilograms   --> mass
+ *
+ * @result   float64    fraction of the molecules, between 0 and 1
+ */
+func (cfg *Config) maxwellBoltzmannCDF(v, T, mass float64) float64 {
+
+	// input validation
+	if T <= 0.0 || mass <= 0.0 || v <= 0.0 {
+		return 0.0
+	}
+
+	// speed in units of the thermal speed sqrt(kT/m)
+	x := v / math.Sqrt(cfg.BoltzmannConstantJoules*T/mass)
+
+	return math.Erf(x/math.Sqrt2) -
+		math.Sqrt(2/math.Pi)*x*math.Exp(-x*x/2)
+}
+
+//! Function to calculate the most probable speed of the molecules of a gas
+//! in thermal equilibrium, i.e. the peak of the Maxwell-Boltzmann
+//! distribution.
+/*
+ * @param    float64    temperature, in Kelvins       --> T
+ * @param    float64    molar mass, in kg per mole    --> molarMass
+ *
+ * @result   float64    most probable speed, in m/s
+ */
+func mostProbableSpeed(T, molarMass float64) float64 {
+
+	// input validation
+	if T <= 0.0 || molarMass <= 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(2 * molarGasConstant * T / molarMass)
+}
+
+//! Function to calculate the mean speed of the molecules of a gas in
+//! thermal equilibrium.
+/*
+ * @param    float64    temperature, in Kelvins       --> T
+ * @param    float64    molar mass, in kg per mole    --> molarMass
+ *
+ * @result   float64    mean speed, in m/s
+ */
+func meanSpeed(T, molarMass float64) float64 {
+
+	// input validation
+	if T <= 0.0 || molarMass <= 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(8 * molarGasConstant * T / (math.Pi * molarMass))
+}
+
+//! Function to calculate the root mean square speed of the molecules of a
+//! gas in thermal equilibrium, which sets their mean kinetic energy.
+/*
+ * @param    float64    temperature, in Kelvins       --> T
+ * @param    float64    molar mass, in kg per mole    --> molarMass
+ *
+ * @result   float64    root mean square speed, in m/s
+ */
+func rmsSpeed(T, molarMass float64) float64 {
+
+	// input validation
+	if T <= 0.0 || molarMass <= 0.0 {
+		return 0.0
+	}
+
+	return math.Sqrt(3 * molarGasConstant * T / molarMass)
+}
+
+//! Function to calculate the luminosity of accretion onto a body, i.e. the
+//! gravitational potential energy released per unit time by matter falling
+//! from far away down to a given radius.
+/*
+ * @param    float64    mass of the accreting body       --> M
+ * @param    float64    rate of accretion, in kg/s       --> mdot
+ * @param    float64    radius the matter falls to       --> r
+ *
+ * @result   float64    luminosity, in Watts
+ */
+func (cfg *Config) accretionLuminosity(M, mdot, r float64) float64 {
+
+	// input validation
+	if r == 0.0 {
+		return 0.0
+	}
+
+	return cfg.G * M * mdot / r
+}
+
+//! Function to calculate the Eddington luminosity of a body, i.e. the
+//! luminosity at which the pressure of its radiation on the electrons of
+//! infalling ionized hydrogen balances the pull of its gravity.
+/*
+ * @param    float64    mass of the body    --> M
+ *
+ * @result   float64    Eddington luminosity, in Watts
+ */
+func (cfg *Config) eddingtonLuminosity(M float64) float64 {
+
+	// input validation
+	if M <= 0.0 {
+		return 0.0
+	}
 
-	// pass back the calcuated value
-	return ratioOfMassToGravity / speedOfLightInVacSquared
+	return 4 * math.Pi * cfg.G * M * protonMass * cfg.C /
+		thomsonCrossSection
 }
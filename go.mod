@@ -0,0 +1,3 @@
+module github.com/rbisewski/goplex
+
+go 1.18
@@ -0,0 +1,98 @@
+/*
+ * Goplex Golden Vectors
+ *
+ * Description: Loads and regenerates the golden file of input / output
+ *              pairs that the registered functions are tested against.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+//
+// Globals
+//
+var (
+
+	// location of the golden file, relative to the repo
+	goldenFilePath = "testdata/golden.json"
+)
+
+// goldenVector is a single set of named inputs to a registered function,
+// along with the result it is expected to give.
+type goldenVector struct {
+	Name     string             `json:"name"`
+	Inputs   map[string]float64 `json:"inputs"`
+	Expected float64            `json:"expected"`
+}
+
+//! Read the golden vectors from a file
+/*
+ * @param    string            path to the golden file --> path
+ *
+ * @result   []goldenVector    list of golden vectors
+ *           error             error message, if any
+ */
+func loadGolden(path string) ([]goldenVector, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var vectors []goldenVector
+	err = json.Unmarshal(data, &vectors)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return vectors, nil
+}
+
+//! Recalculate the expected result of every golden vector in a file, so
+//! that new vectors only need their inputs filled in by hand.
+/*
+ * @param    string    path to the golden file --> path
+ *
+ * @result   error     error message, if any
+ */
+func updateGolden(path string) error {
+
+	vectors, err := loadGolden(path)
+	if err != nil {
+		return err
+	}
+
+	for i, vector := range vectors {
+
+		spec, ok := Registry[vector.Name]
+		if !ok {
+			return fmt.Errorf("%s: unknown function %q", path,
+				vector.Name)
+		}
+
+		vectors[i].Expected, err = spec.Eval(vector.Inputs)
+		if err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(vectors, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -16,9 +16,19 @@ package main
 // Imports
 //
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"image/png"
 	"math"
+	"math/big"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"time"
 )
 
 //
@@ -34,8 +44,56 @@ func main() {
 	var actual float64
 	var expected float64
 
+	// the -update flag regenerates the golden file rather than testing
+	update := flag.Bool("update", false, "regenerate "+goldenFilePath)
+
+	// the -serve flag runs the calculation server rather than testing
+	serve := flag.String("serve", "", "serve calculations on this address")
+
+	// the -bench flag runs the benchmarks rather than testing
+	bench := flag.Bool("bench", false, "run the benchmarks")
+
+	// the -continue flag reports every failed test, rather than stopping
+	// at the first of them
+	keepGoing := flag.Bool("continue", false,
+		"carry on past failed tests and summarize them at the end")
+	flag.Parse()
+
+	if *bench {
+		runBenchmarks()
+		return
+	}
+
+	// any remaining arguments name a function to evaluate
+	if flag.NArg() > 0 {
+		err := dispatch(flag.Args(), os.Stdout)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *serve != "" {
+		fmt.Println("Serving calculations on " + *serve)
+		err := http.ListenAndServe(*serve, newCalcServer())
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if *update {
+		err := updateGolden(goldenFilePath)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println("Updated " + goldenFilePath)
+		return
+	}
+
 	// tell the end-user the tests are starting
 	fmt.Println("Goplex tests begin now...")
+	run := &testRun{keepGoing: *keepGoing}
 
 	//
 	// Tsiolkovsky Delta-V Launch
@@ -51,7 +109,7 @@ func main() {
 		fmt.Println("Tsiolkovsky Delta-V test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
 	}
 
 	//
@@ -66,7 +124,7 @@ func main() {
 		fmt.Println("Photon Energy test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
 	}
 
 	//
@@ -83,7 +141,7 @@ func main() {
 		fmt.Println("Thermal velocity test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
 	}
 
 	//
@@ -98,7 +156,7 @@ func main() {
 		fmt.Println("Lorentz factor test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
 	}
 
 	//
@@ -114,7 +172,7 @@ func main() {
 		fmt.Println("Abraham-Lorentz test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
 	}
 
 	//
@@ -124,6 +182,8 @@ func main() {
 	T := 47.362
 	e := 0.205630
 	expected = 0.065884179454766778
+
+	// scaled by 59 solar days, i.e. roughly one rotation of Mercury
 	actual = perihelionShift(L, T, e) * secondsInADay * 59.0
 
 	// do a quick check to ensure this actually gave the right result
@@ -131,7 +191,7 @@ func main() {
 		fmt.Println("Perihelion Shift test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
 	}
 
 	//
@@ -145,9 +205,2085 @@ func main() {
 		fmt.Println("Schwarzshild radius test failed!")
 		fmt.Println("Expected: ", expected)
 		fmt.Println("Calculated: ", actual)
-		os.Exit(1)
+		run.fail()
+	}
+
+	//
+	// Gravity at the centre and at the surface of the planet Earth
+	//
+	atCentre := gravityAtDepth(massOfTheEarth, radiusOfTheEarth, 0.0)
+	atHalfway := gravityAtDepth(massOfTheEarth, radiusOfTheEarth,
+		radiusOfTheEarth/2.0)
+	atSurface := gravityAtDepth(massOfTheEarth, radiusOfTheEarth,
+		radiusOfTheEarth)
+	aboveSurface := gravityAtDepth(massOfTheEarth, radiusOfTheEarth,
+		radiusOfTheEarth*2.0)
+
+	// gravity should vanish at the centre and peak at the surface
+	if atCentre != 0.0 || atHalfway >= atSurface ||
+		aboveSurface >= atSurface {
+		fmt.Println("Gravity at depth test failed!")
+		fmt.Println("Expected: ", "0 at centre, peak at surface")
+		fmt.Println("Calculated: ", atCentre, atHalfway, atSurface,
+			aboveSurface)
+		run.fail()
+	}
+
+	//
+	// Tidal stretch across the diameter of the Earth, due to the Moon
+	//
+	expected = 2.1985008460366423e-06
+	actual = tidalAcceleration(massOfTheMoon, earthMoonDistance,
+		2.0*radiusOfTheEarth)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Tidal acceleration test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Evaluate every function in the registry against the golden file
+	//
+	vectors, err := loadGolden(goldenFilePath)
+	if err != nil {
+		fmt.Println("Golden file test failed!")
+		fmt.Println(err)
+		run.fail()
+	}
+
+	// gather the inputs of each function that has a golden vector
+	goldenInputs := make(map[string]map[string]float64)
+	for _, vector := range vectors {
+
+		spec, ok := Registry[vector.Name]
+		if !ok {
+			fmt.Println("Golden file test failed!")
+			fmt.Println("Unknown function: ", vector.Name)
+			run.fail()
+			continue
+		}
+		goldenInputs[vector.Name] = vector.Inputs
+
+		actual, err = spec.Eval(vector.Inputs)
+
+		// test to ensure this got the expected result
+		if err != nil || vector.Expected != actual {
+			fmt.Println("Golden file test failed for " + vector.Name + "!")
+			fmt.Println("Expected: ", vector.Expected)
+			fmt.Println("Calculated: ", actual, err)
+			run.fail()
+		}
+	}
+
+	// every registered function needs at least one golden vector
+	for name := range Registry {
+		if _, ok := goldenInputs[name]; !ok {
+			fmt.Println("Golden file test failed!")
+			fmt.Println("Missing golden vector for: ", name)
+			run.fail()
+		}
+	}
+
+	//
+	// Ensure the registry rejects a call with missing parameters
+	//
+	_, err = Registry["photonEnergy"].Eval(map[string]float64{})
+
+	// test to ensure this got the expected result
+	if err == nil {
+		fmt.Println("Registry missing parameter test failed!")
+		fmt.Println("Expected: ", "an error")
+		fmt.Println("Calculated: ", err)
+		run.fail()
+	}
+
+	//
+	// Ensure the registry rejects degrees of freedom that are fractional
+	// or negative, rather than truncating them
+	//
+	for _, dof := range []float64{2.7, -3} {
+
+		_, err = Registry["idealGasInternalEnergy"].Eval(
+			map[string]float64{"n": 1, "T": 300, "degreesOfFreedom": dof})
+
+		// test to ensure this got the expected result
+		if err == nil {
+			fmt.Println("Registry degrees of freedom test failed!")
+			fmt.Println("Expected: ", "an error for", dof)
+			fmt.Println("Calculated: ", err)
+			run.fail()
+		}
+	}
+
+	//
+	// Ensure every registered function describes each of its parameters
+	//
+	for name, spec := range Registry {
+
+		// the number of parameters must match the golden inputs
+		if len(spec.Params) != len(goldenInputs[name]) {
+			fmt.Println("Registry metadata test failed for " + name + "!")
+			fmt.Println("Expected: ", len(goldenInputs[name]))
+			fmt.Println("Calculated: ", len(spec.Params))
+			run.fail()
+		}
+
+		// each parameter needs a name, unit and description
+		for _, param := range spec.Params {
+
+			_, ok := goldenInputs[name][param.Name]
+			if !ok || param.Unit == "" || param.Description == "" {
+				fmt.Println("Registry metadata test failed for " +
+					name + "!")
+				fmt.Println("Incomplete parameter: ", param)
+				run.fail()
+			}
+		}
+	}
+
+	//
+	// GPS satellite clock drift, relative to a clock on the equator
+	//
+	satelliteRate := netClockRate(massOfTheEarth, 26561750.0, 3873.8)
+	groundRate := netClockRate(massOfTheEarth, radiusOfTheEarth, 465.1)
+	driftPerDay := (satelliteRate - groundRate) * secondsInADay
+
+	// the satellite clock ought to run ahead by roughly 38 microseconds
+	if driftPerDay < 0.000035 || driftPerDay > 0.000041 {
+		fmt.Println("GPS clock rate test failed!")
+		fmt.Println("Expected: ", "~38 microseconds per day")
+		fmt.Println("Calculated: ", driftPerDay)
+		run.fail()
+	}
+
+	//
+	// Jeans mass of a cold molecular cloud at 10 K
+	//
+	cloudTemp := 10.0
+	cloudDensity := 3.9 * math.Pow(10, -17)
+	cloudParticleMass := 3.9 * math.Pow(10, -27)
+	expected = 1.0686096043912961e+31
+	actual = jeansMass(cloudTemp, cloudDensity, cloudParticleMass)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Jeans mass test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Free-fall time of the same molecular cloud
+	//
+	expected = 1.0637328395572256e+13
+	actual = freeFallTime(cloudDensity)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Free-fall time test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Doubling the speed of light ought to quarter the Schwarzschild radius
+	//
+	fasterLight := *DefaultConfig
+	fasterLight.C = 2.0 * c
+	expected = schwarzschildRadius(massOfTheEarth) / 4.0
+	actual = fasterLight.schwarzschildRadius(massOfTheEarth)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Config override test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Reduced mass of the Earth-Moon system
+	//
+	expected = 7.252838841574054e+22
+	actual = reducedMass(massOfTheEarth, massOfTheMoon)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Reduced mass test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Earth-Moon barycenter, roughly 4670 km from the centre of the Earth
+	//
+	actual = barycenterDistance(massOfTheEarth, massOfTheMoon,
+		earthMoonDistance)
+
+	// test to ensure this got the expected result
+	if actual < 4660000.0 || actual > 4680000.0 {
+		fmt.Println("Barycenter distance test failed!")
+		fmt.Println("Expected: ", "~4670 km")
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Rotate the X unit vector by 90 degrees about the Z axis
+	//
+	rotated := RotationZ(math.Pi / 2).Mul(Vec3{X: 1})
+
+	// the result ought to be the Y unit vector, within rounding
+	if math.Abs(rotated.X) > 1e-15 || math.Abs(rotated.Y-1) > 1e-15 ||
+		rotated.Z != 0 {
+		fmt.Println("Rotation matrix test failed!")
+		fmt.Println("Expected: ", Vec3{Y: 1})
+		fmt.Println("Calculated: ", rotated)
+		run.fail()
+	}
+
+	//
+	// Rotate by 90 degrees about each axis, with the angle in degrees
+	//
+
+	// the result ought to match the rotation by pi/2 radians
+	if RotationXDeg(90) != RotationX(math.Pi/2) ||
+		RotationYDeg(90) != RotationY(math.Pi/2) ||
+		RotationZDeg(90) != RotationZ(math.Pi/2) {
+		fmt.Println("Rotation matrix in degrees test failed!")
+		fmt.Println("Expected: ", RotationX(math.Pi/2),
+			RotationY(math.Pi/2), RotationZ(math.Pi/2))
+		fmt.Println("Calculated: ", RotationXDeg(90), RotationYDeg(90),
+			RotationZDeg(90))
+		run.fail()
+	}
+
+	//
+	// Boost by 0.5c and then by 0.6c, by adding their rapidities
+	//
+	firstBoost := 0.5 * c
+	secondBoost := 0.6 * c
+	expected = (firstBoost + secondBoost) /
+		(1 + firstBoost*secondBoost/(c*c))
+	actual = velocityFromRapidity(rapidityFromVelocity(firstBoost) +
+		rapidityFromVelocity(secondBoost))
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > expected*1e-15 {
+		fmt.Println("Rapidity addition test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Power radiated by an electron accelerated at 10^18 m/s^2
+	//
+	expected = 5.7083267623217565e-18
+	actual = larmorPower(elementaryCharge, math.Pow(10, 18))
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Larmor power test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Cyclotron frequency of an electron in a 1 Tesla field
+	//
+	expected = 1.7588200107721634e+11
+	actual = cyclotronFrequency(elementaryCharge, 1.0, electronMass)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Cyclotron frequency test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Force on an electron moving through crossed electric and magnetic
+	// fields, which cancel out entirely when v = -E/B
+	//
+	expected = -3.2043532680000003e-16
+	actual = lorentzForceEM(-elementaryCharge, 1000.0, 100000.0, 0.01)
+	balanced := lorentzForceEM(-elementaryCharge, 1000.0, -100000.0, 0.01)
+
+	// test to ensure this got the expected result
+	if expected != actual || balanced != 0.0 {
+		fmt.Println("Lorentz force (EM) test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual, balanced)
+		run.fail()
+	}
+
+	//
+	// Gravity field of the Earth, sampled at one and two radii along X
+	//
+	earthOnly := []float64{massOfTheEarth}
+	earthAtOrigin := []Vec3{{}}
+	nearField := gravityFieldAt(earthOnly, earthAtOrigin,
+		Vec3{X: radiusOfTheEarth})
+	farField := gravityFieldAt(earthOnly, earthAtOrigin,
+		Vec3{X: 2.0 * radiusOfTheEarth})
+
+	// the field points back at the origin and falls off as 1/r^2
+	ratio := nearField.X / farField.X
+	if nearField.X >= 0 || nearField.Y != 0 || nearField.Z != 0 ||
+		math.Abs(ratio-4.0) > 1e-12 {
+		fmt.Println("Gravity field test failed!")
+		fmt.Println("Expected: ", "inverse-square falloff along -X")
+		fmt.Println("Calculated: ", nearField, farField)
+		run.fail()
+	}
+
+	//
+	// Photon sphere of a solar-mass black hole
+	//
+	expected = 1.5 * schwarzschildRadius(massOfTheSun)
+	actual = photonSphereRadius(massOfTheSun)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Photon sphere test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Innermost stable circular orbit of a solar-mass black hole
+	//
+	expected = 6 * universalGravitationConstant * massOfTheSun / (c * c)
+	actual = iscoRadius(massOfTheSun)
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > expected*1e-15 {
+		fmt.Println("ISCO radius test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Surface gravity of the Earth, the Moon and the Sun
+	//
+	gravities := compareSurfaceGravity([]Body{Earth, Moon, Sun,
+		{Name: "Pointlike", Mass: 1.0}})
+
+	// the Moon is weaker than the Earth, which is weaker than the Sun,
+	// and any body without a radius is left out
+	_, hasPointlike := gravities["Pointlike"]
+	if len(gravities) != 3 || hasPointlike ||
+		gravities["Earth"] < 9.7 || gravities["Earth"] > 9.9 ||
+		gravities["Moon"] >= gravities["Earth"] ||
+		gravities["Sun"] <= gravities["Earth"] {
+		fmt.Println("Surface gravity comparison test failed!")
+		fmt.Println("Expected: ", "Moon < Earth (~9.8) < Sun")
+		fmt.Println("Calculated: ", gravities)
+		run.fail()
+	}
+
+	//
+	// Ionization of hydrogen at 10000 K in a stellar atmosphere
+	//
+	hydrogenIonization := 13.6 * elementaryCharge
+	expected = 3.3787746934043077
+	actual = sahaRatio(10000.0, math.Pow(10, 20), hydrogenIonization)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Saha ionization test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Flux of 550nm photons from the Sun, as received at 1 AU
+	//
+	expected = 3.768748982938867e+21
+	actual = photonFlux(solarLuminosity, 550.0*math.Pow(10, -9),
+		astronomicalUnit)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Photon flux test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Format the Schwarzschild radius of the Earth with SI prefixes
+	//
+	formatted := formatSI(schwarzschildRadius(massOfTheEarth), "m")
+	formattedLarge := formatSI(999999.0, "m")
+	formattedHuge := formatSI(massOfTheSun*1000.0, "g")
+	formattedTiny := formatSI(electronMass*1000.0, "g")
+
+	// test to ensure this got the expected result
+	if formatted != "8.87 mm" || formattedLarge != "1 Mm" ||
+		formattedHuge != "1.99e+09 Yg" || formattedTiny != "0.000911 yg" {
+		fmt.Println("SI prefix formatting test failed!")
+		fmt.Println("Expected: ", "8.87 mm", "1 Mm", "1.99e+09 Yg",
+			"0.000911 yg")
+		fmt.Println("Calculated: ", formatted, formattedLarge,
+			formattedHuge, formattedTiny)
+		run.fail()
+	}
+
+	//
+	// Relativistic mass of a 1 kg body moving at 0.8c
+	//
+	expected = 5.0 / 3.0
+	actual = relativisticMass(1.0, 0.8*c)
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > 1e-15 {
+		fmt.Println("Relativistic mass test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Scale height of the atmosphere of the Earth, roughly 8.5 km
+	//
+	airMolarMass := 0.0289644
+	earthScaleHeight := scaleHeight(290.0, airMolarMass, 9.80665)
+
+	// test to ensure this got the expected result
+	if earthScaleHeight < 8400.0 || earthScaleHeight > 8600.0 {
+		fmt.Println("Scale height test failed!")
+		fmt.Println("Expected: ", "~8.5 km")
+		fmt.Println("Calculated: ", earthScaleHeight)
+		run.fail()
+	}
+
+	//
+	// Barometric pressure at exactly one scale height above sea-level
+	//
+	seaLevelPressure := 101325.0
+	expected = seaLevelPressure / math.E
+	actual = barometricPressure(seaLevelPressure, earthScaleHeight,
+		earthScaleHeight)
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > expected*1e-15 {
+		fmt.Println("Barometric pressure test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Softened gravity between the Earth and the Moon, at their actual
+	// separation and with the two placed on top of one another
+	//
+	expected = gravitationalForce(massOfTheEarth, massOfTheMoon,
+		earthMoonDistance)
+	actual = gravitationalForceSoftened(massOfTheEarth, massOfTheMoon,
+		earthMoonDistance, 1000.0)
+	atContact := gravitationalForceSoftened(massOfTheEarth, massOfTheMoon,
+		0.0, 1000.0)
+
+	// the softening is negligible far away, yet keeps the force finite
+	if math.Abs(expected-actual) > expected*1e-10 ||
+		math.IsInf(atContact, 0) || atContact <= 0.0 {
+		fmt.Println("Softened gravitational force test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual, atContact)
+		run.fail()
+	}
+
+	//
+	// Integrate a satellite in a circular orbit around the Earth for one
+	// full period with the leapfrog integrator
+	//
+	orbitRadius := 7000000.0
+	orbitSpeed := math.Sqrt(universalGravitationConstant *
+		massOfTheEarth / orbitRadius)
+	orbitPeriod := 2 * math.Pi * orbitRadius / orbitSpeed
+	orbitMasses := []float64{massOfTheEarth, 1000.0}
+	orbitPos := []Vec3{{}, {X: orbitRadius}}
+	orbitVel := []Vec3{{}, {Y: orbitSpeed}}
+	for t := 0.0; t < orbitPeriod; t += 1.0 {
+		orbitPos, orbitVel = leapfrogStep(orbitPos, orbitVel,
+			orbitMasses, 1.0)
+	}
+	finalRadius := orbitPos[1].Sub(orbitPos[0]).Norm()
+
+	// the radius of the orbit ought to be conserved
+	if math.Abs(finalRadius-orbitRadius) > orbitRadius*1e-4 {
+		fmt.Println("Leapfrog orbit test failed!")
+		fmt.Println("Expected: ", orbitRadius)
+		fmt.Println("Calculated: ", finalRadius)
+		run.fail()
+	}
+
+	//
+	// Total energy of the satellite in a circular orbit, which ought to be
+	// half of its potential energy and conserved by the integrator
+	//
+	expected = gravitationalPotentialEnergy(massOfTheEarth, 1000.0,
+		orbitRadius) / 2.0
+	actual = totalEnergy(orbitMasses, []Vec3{{}, {X: orbitRadius}},
+		[]Vec3{{}, {Y: orbitSpeed}})
+	afterOrbit := totalEnergy(orbitMasses, orbitPos, orbitVel)
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > math.Abs(expected)*1e-12 ||
+		math.Abs(expected-afterOrbit) > math.Abs(expected)*1e-6 {
+		fmt.Println("Total energy test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual, afterOrbit)
+		run.fail()
+	}
+
+	//
+	// Angular momentum of the satellite in its circular orbit, which lies
+	// in the XY plane and so ought to point purely along +Z
+	//
+	angularMomentum := specificAngularMomentum(Vec3{X: orbitRadius},
+		Vec3{Y: orbitSpeed})
+	expected = orbitRadius * orbitSpeed
+	actual = specificAngularMomentumMagnitude(Vec3{X: orbitRadius},
+		Vec3{Y: orbitSpeed})
+
+	// test to ensure this got the expected result
+	if angularMomentum != (Vec3{Z: expected}) || expected != actual {
+		fmt.Println("Specific angular momentum test failed!")
+		fmt.Println("Expected: ", Vec3{Z: expected})
+		fmt.Println("Calculated: ", angularMomentum, actual)
+		run.fail()
+	}
+
+	//
+	// Dot and cross products of the orthogonal unit vectors
+	//
+	unitX := Vec3{X: 1}
+	unitY := Vec3{Y: 1}
+	unitZ := Vec3{Z: 1}
+
+	// orthogonal vectors have no projection onto one another, and the
+	// cross product of any two gives the remaining axis
+	if unitX.Dot(unitY) != 0 || unitY.Dot(unitZ) != 0 ||
+		unitZ.Dot(unitX) != 0 || unitX.Dot(unitX) != 1 ||
+		unitX.Cross(unitY) != unitZ || unitY.Cross(unitZ) != unitX ||
+		unitZ.Cross(unitX) != unitY {
+		fmt.Println("Dot and cross product test failed!")
+		fmt.Println("Expected: ", "X.Y = 0, X x Y = Z")
+		fmt.Println("Calculated: ", unitX.Dot(unitY), unitX.Cross(unitY))
+		run.fail()
 	}
 
-	// otherwise everything turned out fine
-	fmt.Println("All tests completed successfully!")
+	//
+	// Einstein radius of a galaxy of 10^12 solar masses at 1 Gpc, lensing
+	// a source at 2 Gpc
+	//
+	gigaparsec := 3.0857 * math.Pow(10, 25)
+	expected = 9.783013893299447e-06
+	actual = einsteinRadius(massOfTheSun*math.Pow(10, 12), gigaparsec,
+		2.0*gigaparsec, gigaparsec)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Einstein radius test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Calculate the energy of a photon via the calculation server
+	//
+	server := newCalcServer(withRequestTimeout(50 * time.Millisecond))
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder,
+		httptest.NewRequest("GET", "/calc/photonEnergy?l=4e-7", nil))
+
+	// test to ensure this got the expected result
+	if recorder.Code != http.StatusOK ||
+		!strings.Contains(recorder.Body.String(), "4.966114480984395e-19") {
+		fmt.Println("Calculation server test failed!")
+		fmt.Println("Expected: ", http.StatusOK, "4.966114480984395e-19")
+		fmt.Println("Calculated: ", recorder.Code, recorder.Body.String())
+		run.fail()
+	}
+
+	//
+	// Ensure the calculation server refuses a result that is not a finite
+	// number, as well as unknown and repeated parameters
+	//
+	serverErrorCases := []struct {
+		url  string
+		code int
+	}{
+		{"/calc/tsiolkovskyDeltaV?Ve=3000&m0=0&mf=1",
+			http.StatusUnprocessableEntity},
+		{"/calc/photonEnergy?l=4e-7&x=1", http.StatusBadRequest},
+		{"/calc/photonEnergy?l=4e-7&l=5e-7", http.StatusBadRequest},
+	}
+	for _, serverCase := range serverErrorCases {
+
+		recorder = httptest.NewRecorder()
+		server.ServeHTTP(recorder,
+			httptest.NewRequest("GET", serverCase.url, nil))
+
+		// test to ensure this got the expected result
+		if recorder.Code != serverCase.code {
+			fmt.Println("Calculation server error test failed for " +
+				serverCase.url + "!")
+			fmt.Println("Expected: ", serverCase.code)
+			fmt.Println("Calculated: ", recorder.Code,
+				recorder.Body.String())
+			run.fail()
+		}
+	}
+
+	//
+	// Ensure the calculation server abandons a request that runs too long
+	//
+	slowHandler := server.withTimeout(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}))
+	recorder = httptest.NewRecorder()
+	slowHandler.ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+
+	// test to ensure this got the expected result
+	if recorder.Code != http.StatusServiceUnavailable {
+		fmt.Println("Calculation server timeout test failed!")
+		fmt.Println("Expected: ", http.StatusServiceUnavailable)
+		fmt.Println("Calculated: ", recorder.Code)
+		run.fail()
+	}
+
+	//
+	// Gravity along the axis of a ring, which vanishes at the centre and
+	// peaks at a distance of a/sqrt(2)
+	//
+	ringPeak := earthMoonDistance / math.Sqrt2
+	atRingCentre := gravityFromRing(massOfTheMoon, earthMoonDistance, 0.0)
+	atRingPeak := gravityFromRing(massOfTheMoon, earthMoonDistance,
+		ringPeak)
+	belowRingPeak := gravityFromRing(massOfTheMoon, earthMoonDistance,
+		ringPeak*0.99)
+	aboveRingPeak := gravityFromRing(massOfTheMoon, earthMoonDistance,
+		ringPeak*1.01)
+
+	// test to ensure this got the expected result
+	if atRingCentre != 0.0 || belowRingPeak >= atRingPeak ||
+		aboveRingPeak >= atRingPeak {
+		fmt.Println("Gravity from a ring test failed!")
+		fmt.Println("Expected: ", "0 at centre, peak at a/sqrt(2)")
+		fmt.Println("Calculated: ", atRingCentre, belowRingPeak,
+			atRingPeak, aboveRingPeak)
+		run.fail()
+	}
+
+	//
+	// Planck temperature, as derived from the Planck mass
+	//
+	expected = 1.416784 * math.Pow(10, 32)
+	actual = planckTemperature
+
+	// test to ensure this got the expected result, within the precision
+	// of the constants involved
+	if math.Abs(expected-actual) > expected*1e-4 {
+		fmt.Println("Planck temperature test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Fine-structure constant, as derived from the other constants
+	//
+	expected = fineStructureConstant
+	actual = elementaryCharge * elementaryCharge /
+		(4 * math.Pi * vacuumPermittivity * reducedPlanckConstant * c)
+
+	// test to ensure this got the expected result, within the precision
+	// of the constants involved
+	if math.Abs(expected-actual) > expected*1e-6 {
+		fmt.Println("Fine-structure constant test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Doppler factor of a source approaching head-on at 0.6c
+	//
+	expected = 2.0
+	actual = dopplerFactor(0.0, 0.6*c)
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > 1e-15 {
+		fmt.Println("Doppler factor test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Convert the surface gravity of the Earth into km/s^2 and back
+	//
+	earthGravity := surfaceGravity(massOfTheEarth, radiusOfTheEarth)
+	inKilometres, err := convertAcceleration(earthGravity, "m/s^2",
+		"km/s^2")
+	roundTrip, _ := convertAcceleration(inKilometres, "km/s^2", "m/s^2")
+	_, unknownErr := convertAcceleration(earthGravity, "m/s^2", "furlong")
+
+	// test to ensure this got the expected result
+	if err != nil || inKilometres != earthGravity/1000.0 ||
+		roundTrip != earthGravity || unknownErr == nil {
+		fmt.Println("Acceleration conversion test failed!")
+		fmt.Println("Expected: ", earthGravity/1000.0, earthGravity)
+		fmt.Println("Calculated: ", inKilometres, roundTrip, unknownErr)
+		run.fail()
+	}
+
+	//
+	// Energy of a photon with a wavelength of 400nm, in electron-volts
+	//
+	actual = photonEnergyEv(wavelength)
+
+	// test to ensure this got the expected result
+	if actual < 3.09 || actual > 3.11 {
+		fmt.Println("Photon energy (eV) test failed!")
+		fmt.Println("Expected: ", "~3.1 eV")
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Integrate sin(x) from 0 to pi, both via Simpson's rule and via a
+	// seeded Monte Carlo estimate
+	//
+	expected = simpsonIntegrate(math.Sin, 0, math.Pi, 100)
+	actual = monteCarloIntegrate(math.Sin, 0, math.Pi, 100000,
+		rand.New(rand.NewSource(1)))
+	repeated := monteCarloIntegrate(math.Sin, 0, math.Pi, 100000,
+		rand.New(rand.NewSource(1)))
+
+	// both ought to be close to 2, and the same seed gives the same result
+	if math.Abs(expected-2.0) > 1e-7 || math.Abs(expected-actual) > 0.01 ||
+		actual != repeated {
+		fmt.Println("Monte Carlo integration test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual, repeated)
+		run.fail()
+	}
+
+	//
+	// H-alpha line emitted at the surface of the white dwarf Sirius B
+	//
+	hAlpha := 656.28 * math.Pow(10, -9)
+	siriusBMass := 1.018 * massOfTheSun
+	siriusBRadius := 5.8 * math.Pow(10, 6)
+	expected = 6.564501558462037e-07
+	actual = redshiftedWavelength(hAlpha, siriusBMass, siriusBRadius)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Redshifted wavelength test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Schwarzschild radii of a catalog with the Earth, the Sun and an
+	// invalid entry
+	//
+	radii := schwarzschildRadii([]float64{massOfTheEarth, massOfTheSun,
+		-1.0})
+
+	// test to ensure this got the expected result
+	if len(radii) != 3 || radii[0] != schwarzschildRadius(massOfTheEarth) ||
+		radii[1] != schwarzschildRadius(massOfTheSun) || radii[2] != 0.0 {
+		fmt.Println("Schwarzschild radii test failed!")
+		fmt.Println("Expected: ", schwarzschildRadius(massOfTheEarth),
+			schwarzschildRadius(massOfTheSun), 0.0)
+		fmt.Println("Calculated: ", radii)
+		run.fail()
+	}
+
+	//
+	// Clamp values at and beyond the bounds, and interpolate the midpoint
+	//
+	clampedLow := clamp(-5.0, 0.0, 10.0)
+	clampedHigh := clamp(15.0, 0.0, 10.0)
+	clampedSwapped := clamp(15.0, 10.0, 0.0)
+	clampedInside := clamp(5.0, 0.0, 10.0)
+	midpoint := lerp(2.0, 4.0, 0.5)
+
+	// test to ensure this got the expected result
+	if clampedLow != 0.0 || clampedHigh != 10.0 || clampedSwapped != 10.0 ||
+		clampedInside != 5.0 || midpoint != 3.0 || lerp(2, 4, 0) != 2.0 ||
+		lerp(2, 4, 1) != 4.0 {
+		fmt.Println("Clamp and lerp test failed!")
+		fmt.Println("Expected: ", 0.0, 10.0, 10.0, 5.0, 3.0)
+		fmt.Println("Calculated: ", clampedLow, clampedHigh, clampedSwapped,
+			clampedInside, midpoint)
+		run.fail()
+	}
+
+	//
+	// Energy of a massless particle, and of a particle at rest
+	//
+	photonMomentum := planckConstant / wavelength
+	masslessEnergy := energyMomentumRelation(0.0, photonMomentum)
+	restEnergy := energyMomentumRelation(electronMass, 0.0)
+
+	// these reduce to pc and mc^2 respectively
+	if masslessEnergy != photonMomentum*c ||
+		restEnergy != electronMass*c*c {
+		fmt.Println("Energy-momentum relation test failed!")
+		fmt.Println("Expected: ", photonMomentum*c, electronMass*c*c)
+		fmt.Println("Calculated: ", masslessEnergy, restEnergy)
+		run.fail()
+	}
+
+	//
+	// Strain from a pair of 30 solar-mass black holes shortly before they
+	// merge at 410 Mpc, similar to the first detection by LIGO
+	//
+	ligoMass := 30.0 * massOfTheSun
+	ligoDistance := 410.0 * gigaparsec / 1000.0
+	actual = gravitationalWaveStrain(ligoMass, ligoMass, 350000.0,
+		ligoDistance)
+
+	// test to ensure this got the expected order of magnitude
+	if actual < math.Pow(10, -22) || actual > math.Pow(10, -20) {
+		fmt.Println("Gravitational wave strain test failed!")
+		fmt.Println("Expected: ", "~1e-21")
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Lorentz factor of velocities at and beyond the speed of light
+	//
+	superluminal := []float64{c, -c, 2.0 * c, -2.0 * c}
+	for _, v := range superluminal {
+
+		// these all ought to give zero rather than NaN, including for
+		// the functions that build upon the lorentz factor
+		if lorentzFactor(v) != 0.0 ||
+			netClockRate(massOfTheEarth, radiusOfTheEarth, v) != 0.0 {
+			fmt.Println("Superluminal Lorentz factor test failed!")
+			fmt.Println("Expected: ", 0.0)
+			fmt.Println("Calculated: ", lorentzFactor(v),
+				netClockRate(massOfTheEarth, radiusOfTheEarth, v))
+			run.fail()
+		}
+	}
+
+	//
+	// Evaluate a function from the command line, to 3 significant figures
+	//
+	var output bytes.Buffer
+	err = dispatch([]string{"photonEnergy", "--precision", "3", "l=4e-7"},
+		&output)
+
+	// test to ensure this got the expected result
+	if err != nil || output.String() != "4.97e-19\n" {
+		fmt.Println("Command line precision test failed!")
+		fmt.Println("Expected: ", "4.97e-19")
+		fmt.Println("Calculated: ", output.String(), err)
+		run.fail()
+	}
+
+	// without the flag the result is given in full precision
+	output.Reset()
+	err = dispatch([]string{"photonEnergy", "l=4e-7"}, &output)
+	if err != nil || output.String() != "4.966114480984395e-19\n" {
+		fmt.Println("Command line test failed!")
+		fmt.Println("Expected: ", "4.966114480984395e-19")
+		fmt.Println("Calculated: ", output.String(), err)
+		run.fail()
+	}
+
+	// unknown and repeated parameters are rejected, as by the server
+	unknownParamErr := dispatch([]string{"photonEnergy", "l=4e-7", "x=1"},
+		&output)
+	repeatedParamErr := dispatch([]string{"photonEnergy", "l=4e-7", "l=5e-7"},
+		&output)
+	if unknownParamErr == nil || repeatedParamErr == nil {
+		fmt.Println("Command line parameter test failed!")
+		fmt.Println("Expected: ", "unknown parameter: x",
+			"repeated parameter: l")
+		fmt.Println("Calculated: ", unknownParamErr, repeatedParamErr)
+		run.fail()
+	}
+
+	//
+	// Potential energy of masses of 1, 2 and 3 kg placed along a line at
+	// 0, 1 and 3 metres, i.e. -G * (1*2/1 + 2*3/2 + 1*3/3) = -6G
+	//
+	expected = -6 * universalGravitationConstant
+	actual = systemPotentialEnergy([]float64{1, 2, 3},
+		[]Vec3{{X: 0}, {X: 1}, {X: 3}})
+
+	// test to ensure this got the expected result, within rounding
+	if math.Abs(expected-actual) > math.Abs(expected)*1e-15 {
+		fmt.Println("System potential energy test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Round trip an impulsive burn into a delta-v and back again
+	//
+	burnImpulse := 250000.0
+	deltaV := deltaVFromImpulse(burnImpulse, m0)
+	expected = burnImpulse
+	actual = impulseForDeltaV(deltaV, m0)
+
+	// test to ensure this got the expected result
+	if expected != actual || deltaV != 50.0 ||
+		deltaVFromImpulse(burnImpulse, 0.0) != 0.0 {
+		fmt.Println("Impulse to delta-v test failed!")
+		fmt.Println("Expected: ", expected, 50.0)
+		fmt.Println("Calculated: ", actual, deltaV)
+		run.fail()
+	}
+
+	//
+	// Burn the propellant of the Tsiolkovsky test at 10 kg/s, and ensure
+	// integrating the resulting acceleration gives the same delta-v
+	//
+	massFlowRate := 10.0
+	engineThrust := thrust(massFlowRate, Ve)
+	engineBurnTime := burnTime(m0-mf, massFlowRate)
+	acceleration := func(t float64) float64 {
+		return engineThrust / (m0 - massFlowRate*t)
+	}
+	expected = tsiolkovskyDeltaV(Ve, m0, mf)
+	actual = simpsonIntegrate(acceleration, 0, engineBurnTime, 1000)
+
+	// test to ensure this got the expected result, within the error of
+	// the numerical integration
+	if engineBurnTime != 200.0 || math.Abs(expected-actual) > 1e-6 {
+		fmt.Println("Thrust and burn time test failed!")
+		fmt.Println("Expected: ", expected, 200.0)
+		fmt.Println("Calculated: ", actual, engineBurnTime)
+		run.fail()
+	}
+
+	//
+	// Relativistic precession of the perihelion of Mercury, which ought to
+	// add up to roughly 43 arcseconds per century
+	//
+	mercuryOrbitsPerCentury := 36525.0 / 87.969
+	radiansToArcseconds := 180.0 / math.Pi * 3600.0
+	actual = relativisticPerihelionPrecession(massOfTheSun, L*1000.0, e) *
+		mercuryOrbitsPerCentury * radiansToArcseconds
+
+	// test to ensure this got the expected result
+	if actual < 42.5 || actual > 43.5 {
+		fmt.Println("Relativistic perihelion precession test failed!")
+		fmt.Println("Expected: ", "~43 arcseconds per century")
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Bound the relative error of the approximate inverse square root
+	//
+	worstError := 0.0
+	for x := 0.001; x < 1000000.0; x *= 1.1 {
+		exact := 1 / math.Sqrt(x)
+		worstError = math.Max(worstError,
+			math.Abs(fastInvSqrt(x)-exact)/exact)
+	}
+
+	// the field sampler ought to agree when it uses the approximation
+	approxField := sampleGravityField(earthOnly, earthAtOrigin,
+		Vec3{X: radiusOfTheEarth}, true)
+
+	// test to ensure this got the expected result
+	if worstError > 1e-5 ||
+		math.Abs(approxField.X-nearField.X) > math.Abs(nearField.X)*3e-5 {
+		fmt.Println("Fast inverse square root test failed!")
+		fmt.Println("Expected: ", "relative error below 1e-5")
+		fmt.Println("Calculated: ", worstError, approxField, nearField)
+		run.fail()
+	}
+
+	//
+	// Capture cross-section of a 50 km planetesimal sweeping up dust at a
+	// relative velocity of 10 m/s
+	//
+	planetesimalRadius := 50000.0
+	geometric := math.Pi * planetesimalRadius * planetesimalRadius
+	expected = 2.1752638860868015e+11
+	actual = captureCrossSection(math.Pow(10, 18), planetesimalRadius, 10.0)
+
+	// test to ensure this got the expected result, which is enlarged well
+	// beyond the geometric cross-section
+	if expected != actual || actual < 10*geometric {
+		fmt.Println("Capture cross-section test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Solar day of the Earth, from its sidereal day and sidereal year
+	//
+	siderealYear := 365.256363004 * secondsInADay
+	actual = rotationPeriodToSolarDay(secondsInASiderealDay, siderealYear)
+
+	// test to ensure this got the expected result, to within a second
+	if math.Abs(actual-secondsInADay) > 1.0 {
+		fmt.Println("Sidereal to solar day test failed!")
+		fmt.Println("Expected: ", secondsInADay)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Internal energy of a mole of monatomic and diatomic gas at 300 K
+	//
+	monatomic := idealGasInternalEnergy(1.0, 300.0, 3)
+	diatomic := idealGasInternalEnergy(1.0, 300.0, 5)
+
+	// test to ensure this got the expected result
+	if monatomic != 1.5*molarGasConstant*300.0 ||
+		diatomic != 2.5*molarGasConstant*300.0 {
+		fmt.Println("Ideal gas internal energy test failed!")
+		fmt.Println("Expected: ", 1.5*molarGasConstant*300.0,
+			2.5*molarGasConstant*300.0)
+		fmt.Println("Calculated: ", monatomic, diatomic)
+		run.fail()
+	}
+
+	//
+	// Speed of sound in air at 293 K, roughly 343 m/s
+	//
+	soundSpeed := speedOfSound(1.4, 293.0, airMolarMass)
+	mach := machNumber(2.0*soundSpeed, soundSpeed)
+
+	// test to ensure this got the expected result
+	if soundSpeed < 342.0 || soundSpeed > 344.0 || mach != 2.0 {
+		fmt.Println("Speed of sound test failed!")
+		fmt.Println("Expected: ", "~343 m/s, Mach 2")
+		fmt.Println("Calculated: ", soundSpeed, mach)
+		run.fail()
+	}
+
+	//
+	// Typed measurements, which only combine in their own units
+	//
+	distance := Measurement[Meters]{100}.Add(Measurement[Meters]{50})
+	speed := Speed(distance, Measurement[Seconds]{10})
+	earthEscape := EscapeVelocity(Measurement[Kilograms]{massOfTheEarth},
+		Measurement[Meters]{radiusOfTheEarth})
+
+	// test to ensure this got the expected result
+	if speed.String() != "15 m/s" || earthEscape.Value !=
+		escapeVelocity(massOfTheEarth, radiusOfTheEarth) ||
+		earthEscape.Value < 11100 || earthEscape.Value > 11300 {
+		fmt.Println("Typed measurement test failed!")
+		fmt.Println("Expected: ", "15 m/s", "~11.2 km/s")
+		fmt.Println("Calculated: ", speed, earthEscape)
+		run.fail()
+	}
+
+	//
+	// Difference in velocity between low Earth orbits at 400 and 410 km
+	//
+	lowerOrbit := radiusOfTheEarth + 400000.0
+	higherOrbit := radiusOfTheEarth + 410000.0
+	expected = 5.6595136645210005
+	actual = orbitalVelocityDifference(massOfTheEarth, lowerOrbit,
+		higherOrbit)
+
+	// test to ensure this got the expected result, where the lower of
+	// the two orbits is a few m/s faster
+	if expected != actual || actual < 5.0 || actual > 6.0 {
+		fmt.Println("Orbital velocity difference test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Serialize the Earth to JSON and back again
+	//
+	earthJSON, err := json.Marshal(Earth)
+	var decodedEarth Body
+	if err == nil {
+		err = json.Unmarshal(earthJSON, &decodedEarth)
+	}
+
+	// test to ensure this got the expected result
+	if err != nil || decodedEarth != Earth ||
+		!strings.Contains(string(earthJSON), `"name":"Earth"`) {
+		fmt.Println("Body JSON test failed!")
+		fmt.Println("Expected: ", Earth)
+		fmt.Println("Calculated: ", string(earthJSON), decodedEarth, err)
+		run.fail()
+	}
+
+	//
+	// Load a small catalog of bodies and find their escape velocities
+	//
+	catalog, err := LoadBodies(strings.NewReader(`[
+		{"name": "Mars", "mass": 6.4171e23, "radius": 3389500},
+		{"name": "Ceres", "mass": 9.3835e20, "radius": 469730}
+	]`))
+	_, invalidErr := LoadBodies(strings.NewReader(
+		`[{"name": "Nothing", "mass": 0, "radius": 1}]`))
+
+	// test to ensure this got the expected result
+	if err != nil || len(catalog) != 2 || invalidErr == nil ||
+		escapeVelocity(catalog[0].Mass, catalog[0].Radius) < 5000 ||
+		escapeVelocity(catalog[1].Mass, catalog[1].Radius) > 600 {
+		fmt.Println("Body catalog test failed!")
+		fmt.Println("Expected: ", "Mars ~5.0 km/s, Ceres ~0.5 km/s")
+		fmt.Println("Calculated: ", catalog, err, invalidErr)
+		run.fail()
+	}
+
+	//
+	// Sphere of influence of the Earth, relative to the Sun
+	//
+	actual = sphereOfInfluence(astronomicalUnit, massOfTheEarth,
+		massOfTheSun)
+
+	// test to ensure this got the expected result, roughly 925000 km
+	if actual < 9.2*math.Pow(10, 8) || actual > 9.3*math.Pow(10, 8) {
+		fmt.Println("Sphere of influence test failed!")
+		fmt.Println("Expected: ", "~925000 km")
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Proper acceleration of a body moving at 0.5c, seen to accelerate at 1g
+	//
+	expected = 15.098325379595856
+	actual = properAcceleration(halfC, 9.80665)
+
+	// test to ensure this got the expected result
+	if expected != actual {
+		fmt.Println("Proper acceleration test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Histogram of a uniform sweep from 0 to 99, in ten bins
+	//
+	sweep := make([]float64, 100)
+	for i := range sweep {
+		sweep[i] = float64(i)
+	}
+	counts, edges := histogram(sweep, 10)
+	emptyCounts, emptyEdges := histogram(nil, 10)
+
+	// test to ensure each bin holds ten values and the edges span the sweep
+	histogramOk := len(counts) == 10 && len(edges) == 11 &&
+		edges[0] == 0.0 && edges[10] == 99.0 &&
+		len(emptyCounts) == 0 && len(emptyEdges) == 0
+	for i := 0; histogramOk && i < len(counts); i++ {
+		histogramOk = counts[i] == 10
+	}
+	if !histogramOk {
+		fmt.Println("Histogram test failed!")
+		fmt.Println("Expected: ", "10 values in each of 10 bins")
+		fmt.Println("Calculated: ", counts, edges, emptyCounts, emptyEdges)
+		run.fail()
+	}
+
+	// values that are not finite ought to be skipped, leaving the bins of
+	// the sweep as they were
+	nonFiniteSweep := append([]float64{math.NaN(), math.Inf(1)}, sweep...)
+	nonFiniteCounts, nonFiniteEdges := histogram(nonFiniteSweep, 10)
+	if fmt.Sprint(nonFiniteCounts, nonFiniteEdges) !=
+		fmt.Sprint(counts, edges) {
+		fmt.Println("Histogram of values that are not finite test failed!")
+		fmt.Println("Expected: ", counts, edges)
+		fmt.Println("Calculated: ", nonFiniteCounts, nonFiniteEdges)
+		run.fail()
+	}
+
+	//
+	// Pitch of a 1 kHz siren heard from the roadside, as it approaches
+	// and then recedes at 30 m/s
+	//
+	sirenApproaching := classicalDopplerFrequency(1000.0, 30.0, 0.0, 343.0)
+	sirenReceding := classicalDopplerFrequency(1000.0, -30.0, 0.0, 343.0)
+
+	// test to ensure this got the expected result
+	if sirenApproaching != 1095.846645367412 ||
+		sirenReceding != 919.5710455764075 {
+		fmt.Println("Classical Doppler test failed!")
+		fmt.Println("Expected: ", 1095.846645367412, 919.5710455764075)
+		fmt.Println("Calculated: ", sirenApproaching, sirenReceding)
+		run.fail()
+	}
+
+	//
+	// Known values of the Earth and the Sun, as published in the NASA
+	// planetary and solar fact sheets; unlike the golden values these are
+	// independent of this program. The tolerances are relative, and allow
+	// for the bodies not being perfect spheres and for the rounding of the
+	// published figures.
+	//
+	knownValues := []struct {
+		name      string
+		actual    float64
+		expected  float64
+		tolerance float64
+	}{
+		// the mean gravity of the Earth also includes its rotation and
+		// flattening, so it needs the widest allowance
+		{"Earth surface gravity",
+			surfaceGravity(massOfTheEarth, radiusOfTheEarth), 9.798, 5e-3},
+		{"Earth escape velocity",
+			escapeVelocity(massOfTheEarth, radiusOfTheEarth), 11186, 1e-3},
+		{"Earth Schwarzschild radius",
+			schwarzschildRadius(massOfTheEarth), 8.87e-3, 1e-3},
+		{"Sun surface gravity",
+			surfaceGravity(massOfTheSun, radiusOfTheSun), 274.0, 1e-3},
+		{"Sun escape velocity",
+			escapeVelocity(massOfTheSun, radiusOfTheSun), 617600, 1e-3},
+		{"Sun Schwarzschild radius",
+			schwarzschildRadius(massOfTheSun), 2953, 1e-3},
+	}
+
+	// test to ensure each is within its tolerance of the published value
+	for _, known := range knownValues {
+		if math.Abs(known.actual-known.expected) >
+			known.tolerance*known.expected {
+			fmt.Println("Known value test failed for " + known.name + "!")
+			fmt.Println("Expected: ", known.expected)
+			fmt.Println("Calculated: ", known.actual)
+			run.fail()
+		}
+	}
+
+	//
+	// Barnes-Hut approximation of a random cluster of bodies, against the
+	// direct sum; opening no cubes at all ought to give the same result
+	//
+	clusterRng := rand.New(rand.NewSource(1))
+	clusterMasses := make([]float64, 200)
+	clusterPositions := make([]Vec3, 200)
+	for i := range clusterMasses {
+		clusterMasses[i] = massOfTheEarth * (0.5 + clusterRng.Float64())
+		clusterPositions[i] = Vec3{
+			astronomicalUnit * clusterRng.NormFloat64(),
+			astronomicalUnit * clusterRng.NormFloat64(),
+			astronomicalUnit * clusterRng.NormFloat64(),
+		}
+	}
+	direct := nBodyAccelerations(clusterMasses, clusterPositions)
+
+	// test to ensure the overall error, relative to the size of the
+	// accelerations, stays within a bound that loosens with theta
+	for _, tolerance := range []struct {
+		theta    float64
+		maxError float64
+	}{{0.0, 1e-12}, {0.3, 5e-3}, {0.7, 5e-2}} {
+
+		approximate := barnesHutAccelerations(clusterMasses,
+			clusterPositions, tolerance.theta)
+
+		errorSum, accelerationSum := 0.0, 0.0
+		for i := range direct {
+			errorSum += approximate[i].Sub(direct[i]).Norm()
+			accelerationSum += direct[i].Norm()
+		}
+
+		if errorSum/accelerationSum > tolerance.maxError {
+			fmt.Println("Barnes-Hut test failed!")
+			fmt.Println("Expected: ", "relative error below",
+				tolerance.maxError, "at theta", tolerance.theta)
+			fmt.Println("Calculated: ", errorSum/accelerationSum)
+			run.fail()
+		}
+	}
+
+	//
+	// Barnes-Hut approximation of two bodies too close together to be split
+	// apart by the octree, and a third far away from them
+	//
+	closeMasses := []float64{1000, 2000, massOfTheEarth}
+	closePositions := []Vec3{{}, {X: 1e-12}, {X: astronomicalUnit}}
+	closeDirect := nBodyAccelerations(closeMasses, closePositions)
+	closeApproximate := barnesHutAccelerations(closeMasses, closePositions,
+		0.5)
+
+	// test to ensure neither of the close bodies pulls on itself
+	for i := range closeDirect {
+		if closeApproximate[i].Sub(closeDirect[i]).Norm() >
+			1e-12*closeDirect[i].Norm() {
+			fmt.Println("Barnes-Hut close bodies test failed!")
+			fmt.Println("Expected: ", closeDirect)
+			fmt.Println("Calculated: ", closeApproximate)
+			run.fail()
+			break
+		}
+	}
+
+	//
+	// Chirp mass of a binary of two 1.4 solar mass neutron stars, which is
+	// 2^(-1/5) of either mass, roughly 1.22 solar masses
+	//
+	neutronStarMass := 1.4 * massOfTheSun
+	expected = 2.423489150036424e+30
+	actual = chirpMass(neutronStarMass, neutronStarMass)
+
+	// test to ensure this got the expected result
+	if expected != actual ||
+		math.Abs(actual/neutronStarMass-math.Pow(2, -0.2)) > 1e-12 {
+		fmt.Println("Chirp mass test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Distance of the Sun-Earth L1 point from the Earth
+	//
+	actual = lagrangeL1Distance(massOfTheSun, massOfTheEarth,
+		astronomicalUnit)
+
+	// test to ensure this got the expected result, roughly 1.5 million km
+	if actual < 1.45*math.Pow(10, 9) || actual > 1.55*math.Pow(10, 9) {
+		fmt.Println("Lagrange L1 distance test failed!")
+		fmt.Println("Expected: ", "~1.5 million km")
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Export a few points of a parabola for gnuplot
+	//
+	var plot bytes.Buffer
+	err = writeGnuplot(&plot, []float64{0, 1.5, 2}, []float64{0, 2.25, 4})
+	mismatchErr := writeGnuplot(&plot, []float64{0, 1}, []float64{0})
+
+	// test to ensure this got the expected result
+	if err != nil || mismatchErr == nil ||
+		plot.String() != "# x y\n0 0\n1.5 2.25\n2 4\n" {
+		fmt.Println("Gnuplot export test failed!")
+		fmt.Println("Expected: ", "# x y, 0 0, 1.5 2.25, 2 4")
+		fmt.Println("Calculated: ", plot.String(), err, mismatchErr)
+		run.fail()
+	}
+
+	//
+	// Potential around the Earth, sampled on a small grid centred on it
+	//
+	R := radiusOfTheEarth
+	grid := potentialGrid([]float64{massOfTheEarth}, []Vec3{{}},
+		[]float64{-R, 0, R}, []float64{0, R})
+	onSurface := -universalGravitationConstant * massOfTheEarth / R
+
+	// test to ensure this got the expected result
+	if len(grid) != 2 || len(grid[0]) != 3 ||
+		!math.IsInf(grid[0][1], -1) ||
+		grid[0][0] != onSurface || grid[0][2] != onSurface ||
+		grid[1][1] != onSurface ||
+		math.Abs(grid[1][2]-onSurface/math.Sqrt2) > 1e-9*-onSurface {
+		fmt.Println("Potential grid test failed!")
+		fmt.Println("Expected: ", onSurface, "at R, -Inf at the centre")
+		fmt.Println("Calculated: ", grid)
+		run.fail()
+	}
+
+	//
+	// Kinetic energy of an electron at 0.9c, roughly 0.66 MeV
+	//
+	expected = 661313.0412084005
+	actual = relativisticKineticEnergyEv(electronMass, 0.9*c)
+
+	// test to ensure this got the expected result
+	if expected != actual || actual < 0.65*math.Pow(10, 6) ||
+		actual > 0.67*math.Pow(10, 6) {
+		fmt.Println("Relativistic kinetic energy test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Time to fall from twice the radius of the Earth to its surface
+	//
+	expected = 2070.6658528338917
+	actual = radialFreeFallTime(massOfTheEarth, 2*radiusOfTheEarth,
+		radiusOfTheEarth)
+
+	// test to ensure this got the expected result, roughly 35 minutes
+	if expected != actual || actual < 2000.0 || actual > 2150.0 {
+		fmt.Println("Radial free fall time test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Sum ten terms of 1e-16 onto 1, each of which is lost when added
+	// to the running total naively
+	//
+	series := []float64{1.0}
+	for i := 0; i < 10; i++ {
+		series = append(series, math.Pow(10, -16))
+	}
+	naiveSum := 0.0
+	for _, term := range series {
+		naiveSum += term
+	}
+	actual = kahanSum(series)
+
+	// test to ensure this got the expected result
+	if naiveSum != 1.0 || math.Abs(actual-(1+math.Pow(10, -15))) > 2e-16 {
+		fmt.Println("Kahan summation test failed!")
+		fmt.Println("Expected: ", 1+math.Pow(10, -15))
+		fmt.Println("Calculated: ", actual, naiveSum)
+		run.fail()
+	}
+
+	//
+	// Correction of general relativity to Newtonian gravity at the surface
+	// of a 1.4 solar mass neutron star, and at the surface of the Earth
+	//
+	expected = 0.3445449038886835
+	actual = grCorrectionFactor(neutronStarMass, 12000.0)
+	earthCorrection := grCorrectionFactor(massOfTheEarth, radiusOfTheEarth)
+
+	// test to ensure this got the expected result, a third for the neutron
+	// star and around a part per billion for the Earth
+	if expected != actual || actual < 0.3 || actual > 0.4 ||
+		earthCorrection < math.Pow(10, -9) ||
+		earthCorrection > 2*math.Pow(10, -9) {
+		fmt.Println("GR correction factor test failed!")
+		fmt.Println("Expected: ", expected, "~1.4e-9")
+		fmt.Println("Calculated: ", actual, earthCorrection)
+		run.fail()
+	}
+
+	//
+	// Print the version, along with every function of the registry
+	//
+	output.Reset()
+	err = dispatch([]string{"version"}, &output)
+	functions := supportedFunctions()
+	versionLines := strings.Split(strings.TrimSpace(output.String()), "\n")
+
+	// test to ensure the list is sorted, and matches the registry
+	versionOk := err == nil && len(functions) > 0 &&
+		len(functions) == len(Registry) &&
+		len(versionLines) == len(functions)+2 &&
+		strings.HasPrefix(versionLines[0], "goplex ")
+	for i, name := range functions {
+		_, registered := Registry[name]
+		versionOk = versionOk && registered &&
+			strings.TrimSpace(versionLines[i+2]) == name &&
+			(i == 0 || functions[i-1] < name)
+	}
+	if !versionOk {
+		fmt.Println("Version test failed!")
+		fmt.Println("Expected: ", len(Registry), "functions")
+		fmt.Println("Calculated: ", output.String(), err)
+		run.fail()
+	}
+
+	//
+	// Recover the lorentz factor of a proton at 0.8c from its kinetic
+	// energy
+	//
+	expected = lorentzFactor(0.8 * c)
+	actual = lorentzFactorFromKineticEnergy(protonMass,
+		relativisticKineticEnergy(protonMass, 0.8*c))
+
+	// test to ensure this got the expected result
+	if math.Abs(expected-actual) > 1e-12 {
+		fmt.Println("Lorentz factor from kinetic energy test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Render the potential grid around the Earth as a heatmap
+	//
+	var heatmap bytes.Buffer
+	err = writeHeatmapPNG(&heatmap, grid)
+	decoded, decodeErr := png.Decode(&heatmap)
+
+	// test to ensure the image has a pixel for each point of the grid
+	if err != nil || decodeErr != nil || decoded.Bounds().Dx() != 3 ||
+		decoded.Bounds().Dy() != 2 {
+		fmt.Println("Heatmap test failed!")
+		fmt.Println("Expected: ", "a 3x2 image")
+		fmt.Println("Calculated: ", err, decodeErr)
+		run.fail()
+	}
+
+	//
+	// Doppler factor of a source moving at 0.6c, across the line of sight,
+	// with the angle in degrees rather than radians
+	//
+	expected = dopplerFactor(math.Pi/2, 0.6*c)
+	actual = dopplerFactorDeg(90.0, 0.6*c)
+
+	// test to ensure this got the expected result
+	if expected != actual || degreesToRadians(180.0) != math.Pi {
+		fmt.Println("Doppler factor in degrees test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// N-body accelerations under a pluggable force law; the Newtonian law
+	// ought to agree with the direct sum, and a custom law ought to be
+	// called once for each ordered pair of bodies
+	//
+	newtonian := nBodyAccelerationsWithLaw(NewtonianGravity{},
+		clusterMasses, clusterPositions)
+	lawCalls := 0
+	unitForce := ForceLawFunc(func(m1, m2, r float64) float64 {
+		lawCalls++
+		return 1.0
+	})
+	custom := nBodyAccelerationsWithLaw(unitForce, []float64{1, 1},
+		[]Vec3{{}, {X: 2}})
+
+	// test to ensure this got the expected result
+	lawOk := lawCalls == 2 && custom[0] == Vec3{X: 1} &&
+		custom[1] == Vec3{X: -1}
+	for i := range direct {
+		lawOk = lawOk &&
+			newtonian[i].Sub(direct[i]).Norm() < 1e-12*direct[i].Norm()
+	}
+	if !lawOk {
+		fmt.Println("Force law test failed!")
+		fmt.Println("Expected: ", 2, Vec3{X: 1}, Vec3{X: -1})
+		fmt.Println("Calculated: ", lawCalls, custom[0], custom[1])
+		run.fail()
+	}
+
+	//
+	// MOND at the surface of the Earth, where it ought to match Newton,
+	// and far from the Sun, where the Newtonian pull is a hundredth of
+	// the acceleration scale and MOND ought to be ~10 times stronger
+	//
+	mond := MOND{A0: mondAcceleration}
+	mondSurface := mond.Force(1.0, massOfTheEarth, radiusOfTheEarth)
+	newtonSurface := gravitationalForce(1.0, massOfTheEarth,
+		radiusOfTheEarth)
+	deepMondDistance := math.Sqrt(universalGravitationConstant *
+		massOfTheSun / (mondAcceleration / 100))
+	deepMondRatio := mond.Force(1.0, massOfTheSun, deepMondDistance) /
+		gravitationalForce(1.0, massOfTheSun, deepMondDistance)
+
+	// test to ensure this got the expected result
+	if math.Abs(mondSurface-newtonSurface) > 1e-12*newtonSurface ||
+		deepMondRatio < 9.5 || deepMondRatio > 10.5 {
+		fmt.Println("MOND force law test failed!")
+		fmt.Println("Expected: ", newtonSurface, "~10x Newtonian")
+		fmt.Println("Calculated: ", mondSurface, deepMondRatio)
+		run.fail()
+	}
+
+	//
+	// Memoize the force between pairs of bodies, recalculating it only
+	// after the cache is invalidated
+	//
+	pairCalls := 0
+	pairForce := func(i, j int) Vec3 {
+		pairCalls++
+		return Vec3{X: float64(i), Y: float64(j)}
+	}
+	cachedForce, invalidateForces := memoizeForce(pairForce)
+	firstLookup := cachedForce(1, 2)
+	secondLookup := cachedForce(1, 2)
+	cachedForce(2, 1)
+	callsBeforeInvalidate := pairCalls
+	invalidateForces()
+	cachedForce(1, 2)
+
+	// test to ensure this got the expected result
+	if firstLookup != (Vec3{X: 1, Y: 2}) || secondLookup != firstLookup ||
+		callsBeforeInvalidate != 2 || pairCalls != 3 {
+		fmt.Println("Force memoization test failed!")
+		fmt.Println("Expected: ", 2, 3)
+		fmt.Println("Calculated: ", callsBeforeInvalidate, pairCalls)
+		run.fail()
+	}
+
+	//
+	// Invariant mass of two photons of 511 keV each, travelling in
+	// opposite directions, i.e. the annihilation of an electron and a
+	// positron, and of a single photon, which is massless
+	//
+	annihilationPhoton := electronMass * c * c
+	expected = 2 * electronMass
+	actual = invariantMass(2*annihilationPhoton,
+		Vec3{X: annihilationPhoton / c}.Add(
+			Vec3{X: -annihilationPhoton / c}))
+	singlePhoton := invariantMass(annihilationPhoton,
+		Vec3{Y: annihilationPhoton / c})
+
+	// test to ensure this got the expected result
+	if math.Abs(expected-actual) > 1e-12*expected || singlePhoton != 0.0 {
+		fmt.Println("Invariant mass test failed!")
+		fmt.Println("Expected: ", expected, 0.0)
+		fmt.Println("Calculated: ", actual, singlePhoton)
+		run.fail()
+	}
+
+	//
+	// Divide by zero, both directly and via the functions that guard
+	// against it
+	//
+	quotient, quotientOk := safeDiv(6.0, 3.0)
+	byZero, byZeroOk := safeDiv(1.0, 0.0)
+
+	// test to ensure this got the expected result
+	if quotient != 2.0 || !quotientOk || byZero != 0.0 || byZeroOk ||
+		photonEnergy(0.0) != 0.0 || perihelionShift(L, T, 1.0) != 0.0 ||
+		(&Config{}).schwarzschildRadius(massOfTheSun) != 0.0 {
+		fmt.Println("Safe division test failed!")
+		fmt.Println("Expected: ", 2.0, true, 0.0, false)
+		fmt.Println("Calculated: ", quotient, quotientOk, byZero, byZeroOk)
+		run.fail()
+	}
+
+	//
+	// Gravity inside, between and outside of two concentric shells
+	//
+	shellMasses := []float64{massOfTheMoon, massOfTheEarth}
+	shellRadii := []float64{radiusOfTheMoon, radiusOfTheEarth}
+	betweenShells := 0.5 * (radiusOfTheMoon + radiusOfTheEarth)
+	insideShells := shellModelGravity(shellMasses, shellRadii,
+		0.5*radiusOfTheMoon)
+	actual = shellModelGravity(shellMasses, shellRadii, betweenShells)
+	outsideShells := shellModelGravity(shellMasses, shellRadii,
+		2*radiusOfTheEarth)
+	onShell := shellModelGravity([]float64{massOfTheEarth},
+		[]float64{radiusOfTheEarth}, radiusOfTheEarth)
+
+	// test to ensure only the enclosed shells pull on each point
+	if insideShells != 0.0 ||
+		onShell != surfaceGravity(massOfTheEarth, radiusOfTheEarth) ||
+		actual != surfaceGravity(massOfTheMoon, betweenShells) ||
+		outsideShells != surfaceGravity(massOfTheMoon+massOfTheEarth,
+			2*radiusOfTheEarth) {
+		fmt.Println("Shell model gravity test failed!")
+		fmt.Println("Expected: ", 0.0,
+			surfaceGravity(massOfTheMoon, betweenShells),
+			surfaceGravity(massOfTheMoon+massOfTheEarth,
+				2*radiusOfTheEarth),
+			surfaceGravity(massOfTheEarth, radiusOfTheEarth))
+		fmt.Println("Calculated: ", insideShells, actual, outsideShells,
+			onShell)
+		run.fail()
+	}
+
+	//
+	// Electron degeneracy pressure at the density of a white dwarf
+	//
+	expected = 5.0347042085159594e+20
+	actual = degeneracyPressure(math.Pow(10, 35))
+
+	// test to ensure this got the expected result, roughly 5e20 Pa
+	if expected != actual || actual < 4*math.Pow(10, 20) ||
+		actual > 6*math.Pow(10, 20) {
+		fmt.Println("Degeneracy pressure test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Frequency of the gravitational waves from two 1.4 solar mass neutron
+	// stars, 100 km apart, shortly before they merge
+	//
+	expected = 194.0369183373997
+	actual = gwFrequency(orbitalFrequencyFromSeparation(neutronStarMass,
+		neutronStarMass, 100000.0))
+
+	// test to ensure this got the expected result, roughly 190 Hz
+	if expected != actual || actual < 180.0 || actual > 200.0 {
+		fmt.Println("Gravitational wave frequency test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Deflection of starlight grazing the Sun, roughly 1.75 arcseconds,
+	// and back again to the impact parameter
+	//
+	expected = 8.489987159211075e-06
+	actual = lightDeflectionAngle(massOfTheSun, radiusOfTheSun)
+	grazingImpact := impactParameterForDeflection(massOfTheSun, actual)
+
+	// test to ensure this got the expected result
+	if expected != actual ||
+		math.Abs(actual*180/math.Pi*3600-1.75) > 0.01 ||
+		math.Abs(grazingImpact-radiusOfTheSun) > 1e-12*radiusOfTheSun {
+		fmt.Println("Light deflection test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Radiance of the Sun, as a black body at 5772 K, integrated over all
+	// wavelengths, against the Stefan-Boltzmann law
+	//
+	expected = stefanBoltzmannConstant * math.Pow(5772.0, 4) / math.Pi
+	actual = integratedPlanckRadiance(5772.0)
+
+	// test to ensure this got the expected result; the tolerance allows
+	// for the Planck and Boltzmann constants being older CODATA values
+	// than the Stefan-Boltzmann one
+	if math.Abs(expected-actual) > 1e-5*expected {
+		fmt.Println("Integrated Planck radiance test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Energy of a photon with a wavelength of 400nm, to 128 bits, rounded
+	// towards and away from zero; the two ought to straddle the exact value
+	// one unit in the last place apart
+	//
+	towardsZero := (&BigConfig{128, big.ToZero}).photonEnergyBig(4e-7)
+	awayFromZero := (&BigConfig{128, big.AwayFromZero}).photonEnergyBig(4e-7)
+	nearestEven := photonEnergyBig(4e-7)
+	lastPlace := new(big.Float).SetMantExp(big.NewFloat(1),
+		towardsZero.MantExp(nil)-128)
+	roundingGap := new(big.Float).Sub(awayFromZero, towardsZero)
+	nearestFloat64, _ := nearestEven.Float64()
+
+	// test to ensure this got the expected result
+	if roundingGap.Cmp(lastPlace) != 0 ||
+		(nearestEven.Cmp(towardsZero) != 0 &&
+			nearestEven.Cmp(awayFromZero) != 0) ||
+		math.Abs(nearestFloat64-photonEnergy(4e-7)) > 1e-15*nearestFloat64 {
+		fmt.Println("Big float rounding mode test failed!")
+		fmt.Println("Expected: ", lastPlace)
+		fmt.Println("Calculated: ", roundingGap, towardsZero, awayFromZero)
+		run.fail()
+	}
+
+	//
+	// Surface gravity of the Earth from its mean density, against that from
+	// the mass of a sphere of the same density and radius
+	//
+	earthDensity := 5514.0
+	earthMassFromDensity := 4.0 / 3.0 * math.Pi * earthDensity *
+		math.Pow(radiusOfTheEarth, 3)
+	expected = surfaceGravity(earthMassFromDensity, radiusOfTheEarth)
+	actual = surfaceGravityFromDensity(earthDensity, radiusOfTheEarth)
+
+	// test to ensure this got the expected result
+	if math.Abs(expected-actual) > 1e-12*expected {
+		fmt.Println("Surface gravity from density test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Transverse Doppler shift of the H-alpha line from a source at 0.5c,
+	// which ought to be the time dilation of the source, sqrt(3) / 2
+	//
+	hAlphaFrequency := c / hAlpha
+	expected = hAlphaFrequency * math.Sqrt(3) / 2
+	actual = transverseDopplerShift(hAlphaFrequency, halfC)
+
+	// test to ensure this got the expected result, and that it matches the
+	// Doppler factor at right angles to the line of sight
+	if math.Abs(expected-actual) > 1e-12*expected ||
+		math.Abs(hAlphaFrequency*dopplerFactor(math.Pi/2, halfC)-actual) >
+			1e-12*expected {
+		fmt.Println("Transverse Doppler shift test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Acceleration of a test particle at the L4 point of the Earth-Moon
+	// system, at rest in the frame that rotates with the two of them; the
+	// pull of gravity there ought to be balanced by the centrifugal term
+	//
+	earthMoonMass := massOfTheEarth + massOfTheMoon
+	earthPos := Vec3{X: -earthMoonDistance * massOfTheMoon / earthMoonMass}
+	moonPos := Vec3{X: earthMoonDistance * massOfTheEarth / earthMoonMass}
+	l4Pos := Vec3{
+		X: earthPos.X + earthMoonDistance/2,
+		Y: earthMoonDistance * math.Sqrt(3) / 2,
+	}
+	l4Gravity := restrictedThreeBodyAcceleration(massOfTheEarth,
+		massOfTheMoon, earthPos, moonPos, l4Pos)
+	angularSpeedSquared := universalGravitationConstant * earthMoonMass /
+		math.Pow(earthMoonDistance, 3)
+	l4Residual := l4Gravity.Add(l4Pos.Scale(angularSpeedSquared))
+
+	// test to ensure this got the expected result
+	if l4Residual.Norm() > 1e-9*l4Gravity.Norm() {
+		fmt.Println("Restricted three body L4 test failed!")
+		fmt.Println("Expected: ", "~0 m/s^2 in the rotating frame")
+		fmt.Println("Calculated: ", l4Residual.Norm(), l4Gravity.Norm())
+		run.fail()
+	}
+
+	//
+	// Fraction of nitrogen molecules at 300 K that move slower than the
+	// most probable speed, which is erf(1) - 2 / (e sqrt(pi)), ~0.4276
+	//
+	nitrogenMass := 28.0134 * 1.66053906660 * math.Pow(10, -27)
+	nitrogenPeak := math.Sqrt(2 * boltzmannConstantJoules * 300.0 /
+		nitrogenMass)
+	expected = math.Erf(1) - 2/(math.E*math.Sqrt(math.Pi))
+	actual = maxwellBoltzmannCDF(nitrogenPeak, 300.0, nitrogenMass)
+
+	// test to ensure this got the expected result
+	if math.Abs(expected-actual) > 1e-12 ||
+		math.Abs(actual-0.4276) > 1e-4 {
+		fmt.Println("Maxwell-Boltzmann CDF test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Most probable, mean and root mean square speeds of nitrogen at 300 K,
+	// which ought to be in the ratio 1 : 1.128 : 1.225
+	//
+	nitrogenMostProbable := mostProbableSpeed(300.0, 0.0280134)
+	nitrogenMean := meanSpeed(300.0, 0.0280134)
+	nitrogenRms := rmsSpeed(300.0, 0.0280134)
+
+	// test to ensure this got the expected result
+	if math.Abs(nitrogenMean/nitrogenMostProbable-1.128) > 1e-3 ||
+		math.Abs(nitrogenRms/nitrogenMostProbable-1.225) > 1e-3 ||
+		math.Abs(nitrogenMostProbable-nitrogenPeak) > 1e-6*nitrogenPeak {
+		fmt.Println("Maxwell-Boltzmann speeds test failed!")
+		fmt.Println("Expected: ", "1 : 1.128 : 1.225")
+		fmt.Println("Calculated: ", nitrogenMostProbable, nitrogenMean,
+			nitrogenRms)
+		run.fail()
+	}
+
+	//
+	// Trajectory of a body falling from twice the radius of the Earth, in
+	// one second steps, for as long as it takes to reach the surface
+	//
+	fallSteps := int(radialFreeFallTime(massOfTheEarth,
+		2*radiusOfTheEarth, radiusOfTheEarth))
+	trajectory := freeFallTrajectory(massOfTheEarth, 2*radiusOfTheEarth,
+		1.0, fallSteps)
+
+	// test to ensure the body moves inwards ever faster, and ends up at
+	// the surface at the time the closed-form solution gives
+	trajectoryOk := len(trajectory) == fallSteps+1 &&
+		math.Abs(trajectory[fallSteps].X-radiusOfTheEarth) <
+			0.001*radiusOfTheEarth
+	for i := 2; trajectoryOk && i < len(trajectory); i++ {
+		trajectoryOk = trajectory[i].X < trajectory[i-1].X &&
+			trajectory[i-1].X-trajectory[i].X >
+				trajectory[i-2].X-trajectory[i-1].X
+	}
+	if !trajectoryOk {
+		fmt.Println("Free fall trajectory test failed!")
+		fmt.Println("Expected: ", radiusOfTheEarth)
+		fmt.Println("Calculated: ", trajectory[len(trajectory)-1].X)
+		run.fail()
+	}
+
+	//
+	// Print a vector in full, and then to 3 significant figures
+	//
+	printedVector := Vec3{1, -2.5, 3e-7}.String()
+	roundedVector := Vec3{math.Pi, 0, -1234.5}.Format(3)
+
+	// test to ensure this got the expected result
+	if printedVector != "(1, -2.5, 3e-07)" ||
+		roundedVector != "(3.14, 0, -1.23e+03)" {
+		fmt.Println("Vector formatting test failed!")
+		fmt.Println("Expected: ", "(1, -2.5, 3e-07)", "(3.14, 0, -1.23e+03)")
+		fmt.Println("Calculated: ", printedVector, roundedVector)
+		run.fail()
+	}
+
+	//
+	// Luminosity of 1e14 kg/s of matter falling onto the surface of a 1.4
+	// solar mass neutron star, 10 km in radius, which converts ~20% of the
+	// rest energy of the matter into radiation
+	//
+	expected = 1.857969100064e+30
+	actual = accretionLuminosity(neutronStarMass, math.Pow(10, 14),
+		10000.0)
+	accretionEfficiency := actual / (math.Pow(10, 14) * c * c)
+
+	// test to ensure this got the expected result
+	if expected != actual || accretionEfficiency < 0.15 ||
+		accretionEfficiency > 0.25 {
+		fmt.Println("Accretion luminosity test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Eddington luminosity of a body of one solar mass, roughly 1.26e31 W
+	//
+	expected = 1.2570617564034e+31
+	actual = eddingtonLuminosity(massOfTheSun)
+
+	// test to ensure this got the expected result
+	if expected != actual || actual < 1.25*math.Pow(10, 31) ||
+		actual > 1.27*math.Pow(10, 31) {
+		fmt.Println("Eddington luminosity test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Thomson cross-section, as derived from the classical electron radius
+	//
+	classicalElectronRadius := elementaryCharge * elementaryCharge /
+		(4 * math.Pi * vacuumPermittivity * electronMass * c * c)
+	expected = thomsonCrossSection
+	actual = 8 * math.Pi / 3 * classicalElectronRadius *
+		classicalElectronRadius
+
+	// test to ensure this got the expected result, within the precision
+	// of the constants involved
+	if math.Abs(expected-actual) > expected*1e-8 {
+		fmt.Println("Thomson cross-section test failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Doubling the gravitational constant ought to double the surface
+	// gravity and the Eddington luminosity, and doubling the speed of
+	// light ought to quarter the radius of the photon sphere, which only
+	// depends on it by way of the Schwarzschild radius
+	//
+	strongerGravity := *DefaultConfig
+	strongerGravity.G = 2.0 * universalGravitationConstant
+
+	// test to ensure this got the expected result
+	if strongerGravity.surfaceGravity(massOfTheEarth, radiusOfTheEarth) !=
+		2.0*surfaceGravity(massOfTheEarth, radiusOfTheEarth) ||
+		strongerGravity.eddingtonLuminosity(massOfTheSun) !=
+			2.0*eddingtonLuminosity(massOfTheSun) ||
+		fasterLight.photonSphereRadius(massOfTheSun) !=
+			photonSphereRadius(massOfTheSun)/4.0 {
+		fmt.Println("Config override of later functions test failed!")
+		fmt.Println("Expected: ", 2.0, 2.0, 0.25)
+		fmt.Println("Calculated: ",
+			strongerGravity.surfaceGravity(massOfTheEarth,
+				radiusOfTheEarth)/surfaceGravity(massOfTheEarth,
+				radiusOfTheEarth),
+			strongerGravity.eddingtonLuminosity(massOfTheSun)/
+				eddingtonLuminosity(massOfTheSun),
+			fasterLight.photonSphereRadius(massOfTheSun)/
+				photonSphereRadius(massOfTheSun))
+		run.fail()
+	}
+
+	//
+	// Doubling the vacuum permittivity ought to halve the Larmor power
+	//
+	stifferVacuum := *DefaultConfig
+	stifferVacuum.VacuumPermittivity = 2.0 * vacuumPermittivity
+	expected = larmorPower(elementaryCharge, 1e20) / 2.0
+	actual = stifferVacuum.larmorPower(elementaryCharge, 1e20)
+
+	// test to ensure this got the expected result
+	if actual != expected {
+		fmt.Println("Config override of the vacuum permittivity test " +
+			"failed!")
+		fmt.Println("Expected: ", expected)
+		fmt.Println("Calculated: ", actual)
+		run.fail()
+	}
+
+	//
+	// Leapfrog step of two bodies under a custom force law, which ought to
+	// be called for each ordered pair at both kicks, and under MOND, which
+	// ought to pull a distant pair together faster than Newton
+	//
+	lawCalls = 0
+	pairPositions := []Vec3{{}, {X: deepMondDistance}}
+	pairVelocities := []Vec3{{}, {}}
+	pairMasses := []float64{massOfTheSun, 0.0}
+	leapfrogStepWithLaw(unitForce, pairPositions, pairVelocities,
+		pairMasses, 1.0)
+	_, mondVelocities := leapfrogStepWithLaw(mond, pairPositions,
+		pairVelocities, pairMasses, 1.0)
+	_, newtonVelocities := leapfrogStep(pairPositions, pairVelocities,
+		pairMasses, 1.0)
+
+	// test to ensure this got the expected result
+	if lawCalls != 4 ||
+		mondVelocities[1].X > 9.5*newtonVelocities[1].X ||
+		newtonVelocities[1].X >= 0.0 {
+		fmt.Println("Leapfrog force law test failed!")
+		fmt.Println("Expected: ", 4, "~10x Newtonian")
+		fmt.Println("Calculated: ", lawCalls, mondVelocities[1],
+			newtonVelocities[1])
+		run.fail()
+	}
+
+	//
+	// Summarize a run that carries on past two failed tests
+	//
+	summaryRun := &testRun{keepGoing: true}
+	passedSummary := summaryRun.summary()
+	summaryRun.fail()
+	summaryRun.fail()
+
+	// test to ensure this got the expected result
+	if passedSummary != "All tests completed successfully!" ||
+		summaryRun.failures != 2 ||
+		summaryRun.summary() != "2 tests failed!" {
+		fmt.Println("Test run summary test failed!")
+		fmt.Println("Expected: ", "2 tests failed!")
+		fmt.Println("Calculated: ", summaryRun.summary())
+		run.fail()
+	}
+
+	// summarize the run, and exit with an error if any test failed
+	fmt.Println(run.summary())
+	if run.failures > 0 {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,85 @@
+/*
+ * Goplex Numerical Integration
+ *
+ * Description: A set of numerical integrators for functions of a single
+ *              variable, for formulae that have no closed form.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"math/rand"
+)
+
+//! Integrate a function over an interval via the composite Simpson's rule
+/*
+ * @param    func(float64) float64    function to integrate     --> f
+ * @param    float64                  lower bound               --> a
+ * @param    float64                  upper bound               --> b
+ * @param    int                      number of subintervals    --> n
+ *
+ * @result   float64                  definite integral of f
+ */
+func simpsonIntegrate(f func(float64) float64, a, b float64, n int) float64 {
+
+	// input validation
+	if n <= 0 {
+		return 0.0
+	}
+
+	// the rule works on pairs of subintervals
+	if n%2 == 1 {
+		n++
+	}
+
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+
+	// odd points carry a weight of 4, even points a weight of 2
+	for i := 1; i < n; i++ {
+		if i%2 == 1 {
+			sum += 4 * f(a+float64(i)*h)
+		} else {
+			sum += 2 * f(a+float64(i)*h)
+		}
+	}
+
+	return sum * h / 3
+}
+
+//! Estimate the integral of a function over an interval by averaging it
+//! at uniformly random points. The source of randomness is passed in so
+//! that a fixed seed gives a repeatable estimate.
+/*
+ * @param    func(float64) float64    function to integrate     --> f
+ * @param    float64                  lower bound               --> a
+ * @param    float64                  upper bound               --> b
+ * @param    int                      number of samples         --> samples
+ * @param    *rand.Rand               source of randomness      --> rng
+ *
+ * @result   float64                  estimated integral of f
+ */
+func monteCarloIntegrate(f func(float64) float64, a, b float64,
+	samples int, rng *rand.Rand) float64 {
+
+	// input validation
+	if samples <= 0 || rng == nil {
+		return 0.0
+	}
+
+	sum := 0.0
+	for i := 0; i < samples; i++ {
+		sum += f(a + (b-a)*rng.Float64())
+	}
+
+	// the mean value of f, stretched over the width of the interval
+	return (b - a) * sum / float64(samples)
+}
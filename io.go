@@ -0,0 +1,115 @@
+/*
+ * Goplex Output
+ *
+ * Description: Writers that export calculated results in formats other
+ *              programs understand, e.g. for plotting, or as images.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+)
+
+//! Write a series of points as two whitespace separated columns, with a
+//! comment header, which gnuplot can plot as is via `plot "file"`
+/*
+ * @param    io.Writer    destination of the data    --> w
+ * @param    []float64    x coordinates              --> xs
+ * @param    []float64    y coordinates              --> ys
+ *
+ * @result   error        error message, if any
+ */
+func writeGnuplot(w io.Writer, xs, ys []float64) error {
+
+	// input validation
+	if len(xs) != len(ys) {
+		return fmt.Errorf("mismatched columns: %d x values, %d y values",
+			len(xs), len(ys))
+	}
+
+	_, err := fmt.Fprintln(w, "# x y")
+	if err != nil {
+		return err
+	}
+
+	for i := range xs {
+		_, err = fmt.Fprintf(w, "%g %g\n", xs[i], ys[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//! Render a grid of values, e.g. from potentialGrid, as a PNG heatmap that
+//! runs from blue at the smallest value to red at the largest. The first
+//! row of the grid is drawn at the bottom, so that y increases upwards.
+//! Values of -Inf, as at the bodies of a potential grid, are drawn as the
+//! smallest, and +Inf as the largest.
+/*
+ * @param    io.Writer      destination of the image     --> w
+ * @param    [][]float64    values, indexed as [y][x]    --> grid
+ *
+ * @result   error          error message, if any
+ */
+func writeHeatmapPNG(w io.Writer, grid [][]float64) error {
+
+	// input validation
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return fmt.Errorf("empty grid")
+	}
+	width, height := len(grid[0]), len(grid)
+
+	// find the range of the finite values, to normalize against
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for j, row := range grid {
+
+		if len(row) != width {
+			return fmt.Errorf("row %d has %d values, expected %d", j,
+				len(row), width)
+		}
+
+		for _, v := range row {
+			if !math.IsInf(v, 0) && !math.IsNaN(v) {
+				lo = math.Min(lo, v)
+				hi = math.Max(hi, v)
+			}
+		}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for j, row := range grid {
+		for i, v := range row {
+
+			// scale the value to 0 - 255, leaving a flat grid, or a value
+			// that is not a number, at 0
+			level := 0.0
+			if hi > lo && !math.IsNaN(v) {
+				level = clamp((v-lo)/(hi-lo), 0, 1) * 255
+			}
+			if math.IsInf(v, 1) {
+				level = 255
+			}
+
+			shade := uint8(math.Round(level))
+			img.Set(i, height-1-j, color.RGBA{shade, 0, 255 - shade, 255})
+		}
+	}
+
+	return png.Encode(w, img)
+}
@@ -0,0 +1,411 @@
+/*
+ * Goplex N-Body Functions
+ *
+ * Description: A set of functions that work on a collection of bodies
+ *              placed in three dimensional space, e.g. for sampling fields
+ *              or integrating orbits.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"math"
+)
+
+//! Function to calculate the net gravitational acceleration at a point
+//! in space, due to a collection of point masses.
+/*
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    []Vec3       positions of the bodies        --> positions
+ * @param    Vec3         point to sample the field at   --> point
+ *
+ * @result   Vec3         gravitational acceleration, in m/s^2
+ */
+func gravityFieldAt(masses []float64, positions []Vec3, point Vec3) Vec3 {
+	return sampleGravityField(masses, positions, point, false)
+}
+
+//! Function to calculate the net gravitational acceleration at a point
+//! in space, as per gravityFieldAt, optionally approximating the inverse
+//! square root, which is faster but only accurate to around 1e-5.
+/*
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    []Vec3       positions of the bodies        --> positions
+ * @param    Vec3         point to sample the field at   --> point
+ * @param    bool         whether to use fastInvSqrt     --> approximate
+ *
+ * @result   Vec3         gravitational acceleration, in m/s^2
+ */
+func sampleGravityField(masses []float64, positions []Vec3, point Vec3,
+	approximate bool) Vec3 {
+
+	var field Vec3
+
+	// input validation
+	if len(masses) != len(positions) {
+		return field
+	}
+
+	for i, position := range positions {
+
+		// vector from the sample point towards the body
+		separation := position.Sub(point)
+		rSquared := separation.Dot(separation)
+
+		// skip any body that coincides with the sample point
+		if rSquared == 0.0 {
+			continue
+		}
+
+		// inverse of the distance, approximated if so requested
+		var inverseR float64
+		if approximate {
+			inverseR = fastInvSqrt(rSquared)
+		} else {
+			inverseR = 1 / math.Sqrt(rSquared)
+		}
+
+		// inverse-square pull, directed along the unit separation
+		magnitude := DefaultConfig.G * masses[i] *
+			inverseR * inverseR * inverseR
+		field = field.Add(separation.Scale(magnitude))
+	}
+
+	return field
+}
+
+//! Function to calculate the gravitational acceleration of every body in
+//! a collection, due to all of the others.
+/*
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    []Vec3       positions of the bodies        --> positions
+ *
+ * @result   []Vec3       acceleration of each body, in m/s^2
+ */
+func nBodyAccelerations(masses []float64, positions []Vec3) []Vec3 {
+	return nBodyAccelerationsWithLaw(NewtonianGravity{}, masses, positions)
+}
+
+//! Function to calculate the acceleration of every body in a collection,
+//! due to all of the others, under a given force law.
+/*
+ * @param    ForceLaw     law of attraction between two bodies  --> law
+ * @param    []float64    masses of the bodies, in kg           --> masses
+ * @param    []Vec3       positions of the bodies               --> positions
+ *
+ * @result   []Vec3       acceleration of each body, in m/s^2
+ */
+func nBodyAccelerationsWithLaw(law ForceLaw, masses []float64,
+	positions []Vec3) []Vec3 {
+
+	accelerations := make([]Vec3, len(positions))
+
+	// input validation
+	if len(masses) != len(positions) {
+		return accelerations
+	}
+
+	for i := range positions {
+		for j := range positions {
+
+			// vector from the body towards each of the others
+			separation := positions[j].Sub(positions[i])
+			r := separation.Norm()
+
+			// skip the body itself, and any that coincide with it
+			if r == 0.0 {
+				continue
+			}
+
+			// the force on a unit mass is the acceleration, directed
+			// along the unit separation
+			magnitude := law.Force(1.0, masses[j], r)
+			accelerations[i] = accelerations[i].Add(
+				separation.Scale(magnitude / r))
+		}
+	}
+
+	return accelerations
+}
+
+//! Advance a collection of bodies by a single symplectic leapfrog step,
+//! i.e. kick-drift-kick, which keeps the energy of orbits bounded over
+//! long integrations.
+/*
+ * @param    []Vec3       positions of the bodies        --> positions
+ * @param    []Vec3       velocities of the bodies       --> velocities
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    float64      time step, in seconds          --> dt
+ *
+ * @result   []Vec3       positions after the step
+ *           []Vec3       velocities after the step
+ */
+func leapfrogStep(positions, velocities []Vec3, masses []float64,
+	dt float64) (newPos, newVel []Vec3) {
+	return leapfrogStepWithLaw(NewtonianGravity{}, positions, velocities,
+		masses, dt)
+}
+
+//! Advance a collection of bodies by a single leapfrog step, as per
+//! leapfrogStep, under a given force law.
+/*
+ * @param    ForceLaw     law of attraction between two bodies  --> law
+ * @param    []Vec3       positions of the bodies               --> positions
+ * @param    []Vec3       velocities of the bodies              --> velocities
+ * @param    []float64    masses of the bodies, in kg           --> masses
+ * @param    float64      time step, in seconds                 --> dt
+ *
+ * @result   []Vec3       positions after the step
+ *           []Vec3       velocities after the step
+ */
+func leapfrogStepWithLaw(law ForceLaw, positions, velocities []Vec3,
+	masses []float64, dt float64) (newPos, newVel []Vec3) {
+
+	// input validation
+	if len(positions) != len(velocities) || len(positions) != len(masses) {
+		return positions, velocities
+	}
+
+	newPos = make([]Vec3, len(positions))
+	newVel = make([]Vec3, len(velocities))
+
+	// kick the velocities by half a step, then drift the positions
+	accelerations := nBodyAccelerationsWithLaw(law, masses, positions)
+	for i := range positions {
+		newVel[i] = velocities[i].Add(accelerations[i].Scale(dt / 2))
+		newPos[i] = positions[i].Add(newVel[i].Scale(dt))
+	}
+
+	// kick the velocities by the remaining half step, at the new positions
+	accelerations = nBodyAccelerationsWithLaw(law, masses, newPos)
+	for i := range newVel {
+		newVel[i] = newVel[i].Add(accelerations[i].Scale(dt / 2))
+	}
+
+	return newPos, newVel
+}
+
+//! Function to calculate the gravitational potential energy of a
+//! collection of bodies, i.e. the sum over every pair of bodies.
+/*
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    []Vec3       positions of the bodies        --> positions
+ *
+ * @result   float64      potential energy, in Joules
+ */
+func systemPotentialEnergy(masses []float64, positions []Vec3) float64 {
+
+	// input validation
+	if len(masses) != len(positions) {
+		return 0.0
+	}
+
+	var pairs []float64
+
+	// potential energy of each pair, counted once
+	for i := range masses {
+		for j := i + 1; j < len(masses); j++ {
+			r := positions[j].Sub(positions[i]).Norm()
+			pairs = append(pairs,
+				gravitationalPotentialEnergy(masses[i], masses[j], r))
+		}
+	}
+
+	// the pairs can differ by many orders of magnitude, so sum them in a
+	// way that does not lose the small ones
+	return kahanSum(pairs)
+}
+
+//! Function to calculate the total energy of a collection of bodies, i.e.
+//! the sum of their kinetic energy and their pairwise potential energy.
+/*
+ * @param    []float64    masses of the bodies, in kg    --> masses
+ * @param    []Vec3       positions of the bodies        --> positions
+ * @param    []Vec3       velocities of the bodies       --> velocities
+ *
+ * @result   float64      total energy, in Joules
+ */
+func totalEnergy(masses []float64, positions, velocities []Vec3) float64 {
+
+	// input validation
+	if len(masses) != len(positions) || len(masses) != len(velocities) {
+		return 0.0
+	}
+
+	// potential energy of the system as a whole
+	energy := systemPotentialEnergy(masses, positions)
+
+	// kinetic energy of each of the bodies
+	for i, mass := range masses {
+		speed := velocities[i].Norm()
+		energy += 0.5 * mass * speed * speed
+	}
+
+	return energy
+}
+
+//! Function to calculate the specific angular momentum of an orbit, i.e.
+//! the angular momentum per unit mass of the orbiting body.
+/*
+ * @param    Vec3    position relative to the body orbited    --> r
+ * @param    Vec3    velocity relative to the body orbited    --> v
+ *
+ * @result   Vec3    specific angular momentum, in m^2/s
+ */
+func specificAngularMomentum(r Vec3, v Vec3) Vec3 {
+	return r.Cross(v)
+}
+
+//! Function to calculate the magnitude of the specific angular momentum
+/*
+ * @param    Vec3       position relative to the body orbited    --> r
+ * @param    Vec3       velocity relative to the body orbited    --> v
+ *
+ * @result   float64    specific angular momentum, in m^2/s
+ */
+func specificAngularMomentumMagnitude(r Vec3, v Vec3) float64 {
+	return specificAngularMomentum(r, v).Norm()
+}
+
+//! Function to sample the gravitational potential of a collection of
+//! bodies over a grid in the plane z = 0, e.g. for drawing its contours.
+//! Points that coincide with a body are given a potential of -Inf.
+/*
+ * @param    []float64      masses of the bodies, in kg    --> masses
+ * @param    []Vec3         positions of the bodies        --> positions
+ * @param    []float64      x coordinates of the grid      --> xs
+ * @param    []float64      y coordinates of the grid      --> ys
+ *
+ * @result   [][]float64    potential, in J/kg, indexed as [y][x]
+ */
+func potentialGrid(masses []float64, positions []Vec3,
+	xs, ys []float64) [][]float64 {
+
+	grid := make([][]float64, len(ys))
+
+	for j, y := range ys {
+
+		grid[j] = make([]float64, len(xs))
+
+		// input validation, leave the grid empty of any potential
+		if len(masses) != len(positions) {
+			continue
+		}
+
+		for i, x := range xs {
+
+			point := Vec3{X: x, Y: y}
+
+			for k, position := range positions {
+
+				r := position.Sub(point).Norm()
+
+				// the potential diverges at the body itself
+				if r == 0.0 {
+					grid[j][i] = math.Inf(-1)
+					break
+				}
+
+				// potential energy of a unit mass at the point
+				grid[j][i] += gravitationalPotentialEnergy(1.0, masses[k], r)
+			}
+		}
+	}
+
+	return grid
+}
+
+//! Wrap a function giving the force between a pair of bodies, so that each
+//! pair is only calculated once, e.g. across the iterations of a solver.
+//! The cache must be invalidated whenever the bodies move.
+/*
+ * @param    func(i, j int) Vec3    force on body i due to body j  --> f
+ *
+ * @result   func(i, j int) Vec3    the same force, cached by pair
+ *           func()                 clears the cache
+ */
+func memoizeForce(f func(i, j int) Vec3) (cached func(i, j int) Vec3,
+	invalidate func()) {
+
+	cache := make(map[[2]int]Vec3)
+
+	cached = func(i, j int) Vec3 {
+
+		force, ok := cache[[2]int{i, j}]
+		if !ok {
+			force = f(i, j)
+			cache[[2]int{i, j}] = force
+		}
+
+		return force
+	}
+
+	invalidate = func() {
+		cache = make(map[[2]int]Vec3)
+	}
+
+	return cached, invalidate
+}
+
+//! Function to calculate the gravitational acceleration of a massless test
+//! particle in the restricted three body problem, i.e. due to a primary
+//! and a secondary body that it does not disturb in return.
+/*
+ * @param    float64    mass of the primary, in kg       --> mPrimary
+ * @param    float64    mass of the secondary, in kg     --> mSecondary
+ * @param    Vec3       position of the primary          --> primaryPos
+ * @param    Vec3       position of the secondary        --> secondaryPos
+ * @param    Vec3       position of the test particle    --> testPos
+ *
+ * @result   Vec3       gravitational acceleration, in m/s^2
+ */
+func restrictedThreeBodyAcceleration(mPrimary, mSecondary float64,
+	primaryPos, secondaryPos, testPos Vec3) Vec3 {
+
+	return gravityFieldAt([]float64{mPrimary, mSecondary},
+		[]Vec3{primaryPos, secondaryPos}, testPos)
+}
+
+//! Function to integrate the fall of a body, released at rest, straight
+//! down towards a point mass, via the leapfrog integrator. The steps ought
+//! to end before the body reaches the point mass itself.
+/*
+ * @param    float64    mass of the attracting body, in kg   --> M
+ * @param    float64    distance the body is released at     --> r0
+ * @param    float64    time step, in seconds                --> dt
+ * @param    int        number of steps                      --> steps
+ *
+ * @result   []Vec3     position of the body at the release and after each
+ *                      step, relative to the point mass
+ */
+func freeFallTrajectory(M, r0 float64, dt float64, steps int) []Vec3 {
+
+	// input validation
+	if M <= 0.0 || r0 <= 0.0 || dt <= 0.0 || steps < 0 {
+		return nil
+	}
+
+	// the falling body is massless, so the point mass stays put
+	masses := []float64{M, 0.0}
+	positions := []Vec3{{}, {X: r0}}
+	velocities := []Vec3{{}, {}}
+
+	trajectory := make([]Vec3, 0, steps+1)
+	trajectory = append(trajectory, positions[1])
+
+	for i := 0; i < steps; i++ {
+		positions, velocities = leapfrogStep(positions, velocities, masses,
+			dt)
+		trajectory = append(trajectory, positions[1])
+	}
+
+	return trajectory
+}
@@ -0,0 +1,28 @@
+//go:build !bench
+
+/*
+ * Goplex Benchmarks, Disabled
+ *
+ * Description: Stands in for the benchmarks in builds without the bench
+ *              tag, which leave them out of the binary.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"fmt"
+)
+
+//! Explain how to build the program with the benchmarks included
+func runBenchmarks() {
+	fmt.Println("Benchmarks are not built in; rebuild with -tags bench,",
+		"e.g. via `make bench`")
+}
@@ -0,0 +1,864 @@
+/*
+ * Goplex Function Registry
+ *
+ * Description: A table of the functions defined in this package, keyed by
+ *              name, so that they can be discovered and evaluated by other
+ *              tools such as a CLI or HTTP front-end.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"fmt"
+	"math"
+)
+
+// Param describes a single parameter of a function, so that a front-end
+// can render an input for it.
+type Param struct {
+
+	// name of the parameter, as it appears in the source
+	Name string
+
+	// unit the parameter is expected in, e.g. "m/s"
+	Unit string
+
+	// short human readable description of the parameter
+	Description string
+}
+
+// FuncSpec describes a single function of the package, along with the
+// parameters it accepts.
+type FuncSpec struct {
+
+	// name of the function, as it appears in the source
+	Name string
+
+	// parameters, in the order the function takes them
+	Params []Param
+
+	// evaluates the function with the given named arguments
+	Eval func(args map[string]float64) (float64, error)
+}
+
+//! Assemble a FuncSpec from a function that takes its arguments in order
+/*
+ * @param    string                      name of the function    --> name
+ * @param    []Param                     its parameters          --> params
+ * @param    func([]float64) float64     its ordered form        --> fn
+ *
+ * @result   FuncSpec                    function specification
+ */
+func newFuncSpec(name string, params []Param,
+	fn func(x []float64) float64) FuncSpec {
+
+	return newCheckedFuncSpec(name, params,
+		func(x []float64) (float64, error) {
+			return fn(x), nil
+		})
+}
+
+//! Assemble a FuncSpec from a function that takes its arguments in order,
+//! and that may reject them with an error, e.g. when the function needs
+//! an integer argument
+/*
+ * @param    string                              name of the function --> name
+ * @param    []Param                             its parameters       --> params
+ * @param    func([]float64) (float64, error)    its ordered form     --> fn
+ *
+ * @result   FuncSpec                            function specification
+ */
+func newCheckedFuncSpec(name string, params []Param,
+	fn func(x []float64) (float64, error)) FuncSpec {
+
+	// evaluator that maps the named arguments onto the ordered list
+	eval := func(args map[string]float64) (float64, error) {
+
+		x := make([]float64, len(params))
+		for i, param := range params {
+
+			value, ok := args[param.Name]
+			if !ok {
+				return 0, fmt.Errorf("%s: missing parameter %q",
+					name, param.Name)
+			}
+			x[i] = value
+		}
+
+		return fn(x)
+	}
+
+	return FuncSpec{Name: name, Params: params, Eval: eval}
+}
+
+//
+// Globals
+//
+var (
+
+	// Registry of every function in the package, keyed by name
+	Registry = map[string]FuncSpec{
+
+		"tsiolkovskyDeltaV": newFuncSpec("tsiolkovskyDeltaV",
+			[]Param{
+				{"Ve", "m/s", "effective exhaust velocity"},
+				{"m0", "kg", "initial total mass, with propellant"},
+				{"mf", "kg", "final total mass, without propellant"},
+			},
+			func(x []float64) float64 {
+				return tsiolkovskyDeltaV(x[0], x[1], x[2])
+			}),
+
+		"photonEnergy": newFuncSpec("photonEnergy",
+			[]Param{
+				{"l", "m", "wavelength of the photon"},
+			},
+			func(x []float64) float64 {
+				return photonEnergy(x[0])
+			}),
+
+		"thermalVelocityOfHeatedGas": newFuncSpec(
+			"thermalVelocityOfHeatedGas",
+			[]Param{
+				{"g", "m/s^2", "gravity acceleration at sea-level"},
+				{"T", "K", "temperature of the gas"},
+				{"m", "kg", "mass of exhaust, per molecule"},
+			},
+			func(x []float64) float64 {
+				return thermalVelocityOfHeatedGas(x[0], x[1], x[2])
+			}),
+
+		"lorentzFactor": newFuncSpec("lorentzFactor",
+			[]Param{
+				{"v", "m/s", "velocity"},
+			},
+			func(x []float64) float64 {
+				return lorentzFactor(x[0])
+			}),
+
+		"abrahamLorentzForce": newFuncSpec("abrahamLorentzForce",
+			[]Param{
+				{"q", "C", "charge"},
+				{"e0", "F/m", "electric constant"},
+				{"a", "m/s^3", "jerk"},
+			},
+			func(x []float64) float64 {
+				return abrahamLorentzForce(x[0], x[1], x[2])
+			}),
+
+		"perihelionShift": newFuncSpec("perihelionShift",
+			[]Param{
+				{"L", "km", "semi-major axis"},
+				{"T", "km/s", "orbital speed"},
+				{"e", "dimensionless", "orbital eccentricity"},
+			},
+			func(x []float64) float64 {
+				return perihelionShift(x[0], x[1], x[2])
+			}),
+
+		"schwarzschildRadius": newFuncSpec("schwarzschildRadius",
+			[]Param{
+				{"M", "kg", "mass"},
+			},
+			func(x []float64) float64 {
+				return schwarzschildRadius(x[0])
+			}),
+
+		"gravityAtDepth": newFuncSpec("gravityAtDepth",
+			[]Param{
+				{"M", "kg", "mass of the sphere"},
+				{"R", "m", "radius of the sphere"},
+				{"r", "m", "distance from the centre"},
+			},
+			func(x []float64) float64 {
+				return gravityAtDepth(x[0], x[1], x[2])
+			}),
+
+		"tidalAcceleration": newFuncSpec("tidalAcceleration",
+			[]Param{
+				{"M", "kg", "mass of the attracting body"},
+				{"r", "m", "distance to the attracting body"},
+				{"dr", "m", "separation between the two points"},
+			},
+			func(x []float64) float64 {
+				return tidalAcceleration(x[0], x[1], x[2])
+			}),
+
+		"gravitationalTimeDilation": newFuncSpec(
+			"gravitationalTimeDilation",
+			[]Param{
+				{"M", "kg", "mass of the attracting body"},
+				{"r", "m", "distance from its centre"},
+			},
+			func(x []float64) float64 {
+				return gravitationalTimeDilation(x[0], x[1])
+			}),
+
+		"netClockRate": newFuncSpec("netClockRate",
+			[]Param{
+				{"M", "kg", "mass of the attracting body"},
+				{"r", "m", "distance from its centre"},
+				{"v", "m/s", "orbital speed"},
+			},
+			func(x []float64) float64 {
+				return netClockRate(x[0], x[1], x[2])
+			}),
+
+		"jeansMass": newFuncSpec("jeansMass",
+			[]Param{
+				{"T", "K", "temperature of the cloud"},
+				{"density", "kg/m^3", "density of the cloud"},
+				{"meanMolecularMass", "kg", "mass of a gas particle"},
+			},
+			func(x []float64) float64 {
+				return jeansMass(x[0], x[1], x[2])
+			}),
+
+		"freeFallTime": newFuncSpec("freeFallTime",
+			[]Param{
+				{"density", "kg/m^3", "density of the cloud"},
+			},
+			func(x []float64) float64 {
+				return freeFallTime(x[0])
+			}),
+
+		"reducedMass": newFuncSpec("reducedMass",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+			},
+			func(x []float64) float64 {
+				return reducedMass(x[0], x[1])
+			}),
+
+		"barycenterDistance": newFuncSpec("barycenterDistance",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+				{"separation", "m", "separation between the bodies"},
+			},
+			func(x []float64) float64 {
+				return barycenterDistance(x[0], x[1], x[2])
+			}),
+
+		"rapidityFromVelocity": newFuncSpec("rapidityFromVelocity",
+			[]Param{
+				{"v", "m/s", "velocity"},
+			},
+			func(x []float64) float64 {
+				return rapidityFromVelocity(x[0])
+			}),
+
+		"velocityFromRapidity": newFuncSpec("velocityFromRapidity",
+			[]Param{
+				{"phi", "dimensionless", "rapidity"},
+			},
+			func(x []float64) float64 {
+				return velocityFromRapidity(x[0])
+			}),
+
+		"larmorPower": newFuncSpec("larmorPower",
+			[]Param{
+				{"q", "C", "charge"},
+				{"a", "m/s^2", "acceleration"},
+			},
+			func(x []float64) float64 {
+				return larmorPower(x[0], x[1])
+			}),
+
+		"cyclotronFrequency": newFuncSpec("cyclotronFrequency",
+			[]Param{
+				{"q", "C", "charge"},
+				{"B", "T", "magnetic field"},
+				{"m", "kg", "mass of the particle"},
+			},
+			func(x []float64) float64 {
+				return cyclotronFrequency(x[0], x[1], x[2])
+			}),
+
+		"lorentzForceEM": newFuncSpec("lorentzForceEM",
+			[]Param{
+				{"q", "C", "charge"},
+				{"E", "V/m", "electric field"},
+				{"v", "m/s", "velocity of the charge"},
+				{"B", "T", "magnetic field"},
+			},
+			func(x []float64) float64 {
+				return lorentzForceEM(x[0], x[1], x[2], x[3])
+			}),
+
+		"photonSphereRadius": newFuncSpec("photonSphereRadius",
+			[]Param{
+				{"M", "kg", "mass of the black hole"},
+			},
+			func(x []float64) float64 {
+				return photonSphereRadius(x[0])
+			}),
+
+		"iscoRadius": newFuncSpec("iscoRadius",
+			[]Param{
+				{"M", "kg", "mass of the black hole"},
+			},
+			func(x []float64) float64 {
+				return iscoRadius(x[0])
+			}),
+
+		"surfaceGravity": newFuncSpec("surfaceGravity",
+			[]Param{
+				{"M", "kg", "mass of the body"},
+				{"r", "m", "radius of the body"},
+			},
+			func(x []float64) float64 {
+				return surfaceGravity(x[0], x[1])
+			}),
+
+		"sahaRatio": newFuncSpec("sahaRatio",
+			[]Param{
+				{"T", "K", "temperature of the gas"},
+				{"electronDensity", "m^-3", "electron density"},
+				{"ionizationEnergy", "J", "ionization energy"},
+			},
+			func(x []float64) float64 {
+				return sahaRatio(x[0], x[1], x[2])
+			}),
+
+		"photonFlux": newFuncSpec("photonFlux",
+			[]Param{
+				{"luminosity", "W", "luminosity of the source"},
+				{"wavelength", "m", "wavelength of the light"},
+				{"distance", "m", "distance from the source"},
+			},
+			func(x []float64) float64 {
+				return photonFlux(x[0], x[1], x[2])
+			}),
+
+		"relativisticMass": newFuncSpec("relativisticMass",
+			[]Param{
+				{"m0", "kg", "rest mass"},
+				{"v", "m/s", "velocity"},
+			},
+			func(x []float64) float64 {
+				return relativisticMass(x[0], x[1])
+			}),
+
+		"scaleHeight": newFuncSpec("scaleHeight",
+			[]Param{
+				{"T", "K", "temperature of the atmosphere"},
+				{"molarMass", "kg/mol", "molar mass of the gas"},
+				{"g", "m/s^2", "gravitational acceleration"},
+			},
+			func(x []float64) float64 {
+				return scaleHeight(x[0], x[1], x[2])
+			}),
+
+		"barometricPressure": newFuncSpec("barometricPressure",
+			[]Param{
+				{"p0", "Pa", "pressure at zero altitude"},
+				{"altitude", "m", "altitude"},
+				{"scaleHeight", "m", "scale height of the atmosphere"},
+			},
+			func(x []float64) float64 {
+				return barometricPressure(x[0], x[1], x[2])
+			}),
+
+		"gravitationalForce": newFuncSpec("gravitationalForce",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+				{"r", "m", "separation of the bodies"},
+			},
+			func(x []float64) float64 {
+				return gravitationalForce(x[0], x[1], x[2])
+			}),
+
+		"gravitationalForceSoftened": newFuncSpec(
+			"gravitationalForceSoftened",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+				{"r", "m", "separation of the bodies"},
+				{"softening", "m", "softening length"},
+			},
+			func(x []float64) float64 {
+				return gravitationalForceSoftened(x[0], x[1], x[2], x[3])
+			}),
+
+		"gravitationalPotentialEnergy": newFuncSpec(
+			"gravitationalPotentialEnergy",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+				{"r", "m", "separation of the bodies"},
+			},
+			func(x []float64) float64 {
+				return gravitationalPotentialEnergy(x[0], x[1], x[2])
+			}),
+
+		"einsteinRadius": newFuncSpec("einsteinRadius",
+			[]Param{
+				{"M", "kg", "mass of the lens"},
+				{"dL", "m", "distance to the lens"},
+				{"dS", "m", "distance to the source"},
+				{"dLS", "m", "distance from lens to source"},
+			},
+			func(x []float64) float64 {
+				return einsteinRadius(x[0], x[1], x[2], x[3])
+			}),
+
+		"gravityFromRing": newFuncSpec("gravityFromRing",
+			[]Param{
+				{"M", "kg", "mass of the ring"},
+				{"ringRadius", "m", "radius of the ring"},
+				{"axialDistance", "m", "distance along the axis"},
+			},
+			func(x []float64) float64 {
+				return gravityFromRing(x[0], x[1], x[2])
+			}),
+
+		"dopplerFactor": newFuncSpec("dopplerFactor",
+			[]Param{
+				{"theta", "rad", "angle between velocity and line of sight"},
+				{"v", "m/s", "velocity of the source"},
+			},
+			func(x []float64) float64 {
+				return dopplerFactor(x[0], x[1])
+			}),
+
+		"photonEnergyEv": newFuncSpec("photonEnergyEv",
+			[]Param{
+				{"l", "m", "wavelength of the photon"},
+			},
+			func(x []float64) float64 {
+				return photonEnergyEv(x[0])
+			}),
+
+		"gravitationalRedshift": newFuncSpec("gravitationalRedshift",
+			[]Param{
+				{"M", "kg", "mass of the attracting body"},
+				{"r", "m", "distance of the emitter"},
+			},
+			func(x []float64) float64 {
+				return gravitationalRedshift(x[0], x[1])
+			}),
+
+		"redshiftedWavelength": newFuncSpec("redshiftedWavelength",
+			[]Param{
+				{"l0", "m", "emitted wavelength"},
+				{"M", "kg", "mass of the attracting body"},
+				{"r", "m", "distance of the emitter"},
+			},
+			func(x []float64) float64 {
+				return redshiftedWavelength(x[0], x[1], x[2])
+			}),
+
+		"energyMomentumRelation": newFuncSpec("energyMomentumRelation",
+			[]Param{
+				{"m", "kg", "rest mass"},
+				{"p", "kg m/s", "momentum"},
+			},
+			func(x []float64) float64 {
+				return energyMomentumRelation(x[0], x[1])
+			}),
+
+		"gravitationalWaveStrain": newFuncSpec(
+			"gravitationalWaveStrain",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+				{"r", "m", "separation of the bodies"},
+				{"distance", "m", "distance to the observer"},
+			},
+			func(x []float64) float64 {
+				return gravitationalWaveStrain(x[0], x[1], x[2], x[3])
+			}),
+
+		"deltaVFromImpulse": newFuncSpec("deltaVFromImpulse",
+			[]Param{
+				{"impulse", "N s", "impulse of the burn"},
+				{"mass", "kg", "mass of the vehicle"},
+			},
+			func(x []float64) float64 {
+				return deltaVFromImpulse(x[0], x[1])
+			}),
+
+		"impulseForDeltaV": newFuncSpec("impulseForDeltaV",
+			[]Param{
+				{"deltaV", "m/s", "change in velocity"},
+				{"mass", "kg", "mass of the vehicle"},
+			},
+			func(x []float64) float64 {
+				return impulseForDeltaV(x[0], x[1])
+			}),
+
+		"thrust": newFuncSpec("thrust",
+			[]Param{
+				{"massFlowRate", "kg/s", "mass flow rate"},
+				{"exhaustVelocity", "m/s", "effective exhaust velocity"},
+			},
+			func(x []float64) float64 {
+				return thrust(x[0], x[1])
+			}),
+
+		"burnTime": newFuncSpec("burnTime",
+			[]Param{
+				{"propellantMass", "kg", "mass of propellant"},
+				{"massFlowRate", "kg/s", "mass flow rate"},
+			},
+			func(x []float64) float64 {
+				return burnTime(x[0], x[1])
+			}),
+
+		"relativisticPerihelionPrecession": newFuncSpec(
+			"relativisticPerihelionPrecession",
+			[]Param{
+				{"M", "kg", "mass of the body orbited"},
+				{"a", "m", "semi-major axis"},
+				{"e", "dimensionless", "orbital eccentricity"},
+			},
+			func(x []float64) float64 {
+				return relativisticPerihelionPrecession(x[0], x[1], x[2])
+			}),
+
+		"captureCrossSection": newFuncSpec("captureCrossSection",
+			[]Param{
+				{"M", "kg", "mass of the body"},
+				{"r", "m", "radius of the body"},
+				{"vInfinity", "m/s", "relative velocity at infinity"},
+			},
+			func(x []float64) float64 {
+				return captureCrossSection(x[0], x[1], x[2])
+			}),
+
+		"rotationPeriodToSolarDay": newFuncSpec(
+			"rotationPeriodToSolarDay",
+			[]Param{
+				{"siderealSeconds", "s", "sidereal rotation period"},
+				{"orbitalPeriodSeconds", "s", "orbital period"},
+			},
+			func(x []float64) float64 {
+				return rotationPeriodToSolarDay(x[0], x[1])
+			}),
+
+		"idealGasInternalEnergy": newCheckedFuncSpec("idealGasInternalEnergy",
+			[]Param{
+				{"n", "mol", "amount of gas"},
+				{"T", "K", "temperature of the gas"},
+				{"degreesOfFreedom", "dimensionless", "degrees of freedom per molecule"},
+			},
+			func(x []float64) (float64, error) {
+
+				// a fraction of a degree of freedom has no meaning
+				if x[2] < 1 || math.IsInf(x[2], 0) ||
+					x[2] != math.Trunc(x[2]) {
+					return 0, fmt.Errorf("idealGasInternalEnergy: "+
+						"degreesOfFreedom must be a positive integer, "+
+						"got %g", x[2])
+				}
+
+				return idealGasInternalEnergy(x[0], x[1], int(x[2])), nil
+			}),
+
+		"speedOfSound": newFuncSpec("speedOfSound",
+			[]Param{
+				{"gamma", "dimensionless", "adiabatic index of the gas"},
+				{"T", "K", "temperature of the gas"},
+				{"molarMass", "kg/mol", "molar mass of the gas"},
+			},
+			func(x []float64) float64 {
+				return speedOfSound(x[0], x[1], x[2])
+			}),
+
+		"machNumber": newFuncSpec("machNumber",
+			[]Param{
+				{"v", "m/s", "velocity"},
+				{"speedOfSound", "m/s", "local speed of sound"},
+			},
+			func(x []float64) float64 {
+				return machNumber(x[0], x[1])
+			}),
+
+		"escapeVelocity": newFuncSpec("escapeVelocity",
+			[]Param{
+				{"M", "kg", "mass of the body"},
+				{"r", "m", "radius of the body"},
+			},
+			func(x []float64) float64 {
+				return escapeVelocity(x[0], x[1])
+			}),
+
+		"circularOrbitalVelocity": newFuncSpec(
+			"circularOrbitalVelocity",
+			[]Param{
+				{"M", "kg", "mass of the body orbited"},
+				{"r", "m", "radius of the orbit"},
+			},
+			func(x []float64) float64 {
+				return circularOrbitalVelocity(x[0], x[1])
+			}),
+
+		"orbitalVelocityDifference": newFuncSpec(
+			"orbitalVelocityDifference",
+			[]Param{
+				{"M", "kg", "mass of the body orbited"},
+				{"r1", "m", "radius of the first orbit"},
+				{"r2", "m", "radius of the second orbit"},
+			},
+			func(x []float64) float64 {
+				return orbitalVelocityDifference(x[0], x[1], x[2])
+			}),
+
+		"sphereOfInfluence": newFuncSpec("sphereOfInfluence",
+			[]Param{
+				{"a", "m", "semi-major axis of the orbit"},
+				{"mSatellite", "kg", "mass of the orbiting body"},
+				{"mPrimary", "kg", "mass of the primary"},
+			},
+			func(x []float64) float64 {
+				return sphereOfInfluence(x[0], x[1], x[2])
+			}),
+
+		"properAcceleration": newFuncSpec("properAcceleration",
+			[]Param{
+				{"v", "m/s", "velocity"},
+				{"coordinateAccel", "m/s^2", "coordinate acceleration"},
+			},
+			func(x []float64) float64 {
+				return properAcceleration(x[0], x[1])
+			}),
+
+		"classicalDopplerFrequency": newFuncSpec(
+			"classicalDopplerFrequency",
+			[]Param{
+				{"f0", "Hz", "emitted frequency"},
+				{"vSource", "m/s", "speed of the source towards the observer"},
+				{"vObserver", "m/s", "speed of the observer towards the source"},
+				{"soundSpeed", "m/s", "speed of sound in the medium"},
+			},
+			func(x []float64) float64 {
+				return classicalDopplerFrequency(x[0], x[1], x[2], x[3])
+			}),
+
+		"chirpMass": newFuncSpec("chirpMass",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+			},
+			func(x []float64) float64 {
+				return chirpMass(x[0], x[1])
+			}),
+
+		"lagrangeL1Distance": newFuncSpec("lagrangeL1Distance",
+			[]Param{
+				{"mPrimary", "kg", "mass of the primary"},
+				{"mSecondary", "kg", "mass of the secondary"},
+				{"separation", "m", "separation of the two bodies"},
+			},
+			func(x []float64) float64 {
+				return lagrangeL1Distance(x[0], x[1], x[2])
+			}),
+
+		"relativisticKineticEnergy": newFuncSpec(
+			"relativisticKineticEnergy",
+			[]Param{
+				{"m", "kg", "rest mass"},
+				{"v", "m/s", "velocity"},
+			},
+			func(x []float64) float64 {
+				return relativisticKineticEnergy(x[0], x[1])
+			}),
+
+		"relativisticKineticEnergyEv": newFuncSpec(
+			"relativisticKineticEnergyEv",
+			[]Param{
+				{"m", "kg", "rest mass"},
+				{"v", "m/s", "velocity"},
+			},
+			func(x []float64) float64 {
+				return relativisticKineticEnergyEv(x[0], x[1])
+			}),
+
+		"radialFreeFallTime": newFuncSpec("radialFreeFallTime",
+			[]Param{
+				{"M", "kg", "mass of the attracting body"},
+				{"r0", "m", "distance the body is released at"},
+				{"rFinal", "m", "distance the body falls to"},
+			},
+			func(x []float64) float64 {
+				return radialFreeFallTime(x[0], x[1], x[2])
+			}),
+
+		"grCorrectionFactor": newFuncSpec("grCorrectionFactor",
+			[]Param{
+				{"M", "kg", "mass of the attracting body"},
+				{"r", "m", "distance from the body"},
+			},
+			func(x []float64) float64 {
+				return grCorrectionFactor(x[0], x[1])
+			}),
+
+		"lorentzFactorFromKineticEnergy": newFuncSpec(
+			"lorentzFactorFromKineticEnergy",
+			[]Param{
+				{"m", "kg", "rest mass"},
+				{"ke", "J", "kinetic energy"},
+			},
+			func(x []float64) float64 {
+				return lorentzFactorFromKineticEnergy(x[0], x[1])
+			}),
+
+		"dopplerFactorDeg": newFuncSpec("dopplerFactorDeg",
+			[]Param{
+				{"thetaDeg", "degrees", "angle between velocity and line of sight"},
+				{"v", "m/s", "velocity of the source"},
+			},
+			func(x []float64) float64 {
+				return dopplerFactorDeg(x[0], x[1])
+			}),
+
+		"degeneracyPressure": newFuncSpec("degeneracyPressure",
+			[]Param{
+				{"numberDensity", "1/m^3", "number density of electrons"},
+			},
+			func(x []float64) float64 {
+				return degeneracyPressure(x[0])
+			}),
+
+		"gwFrequency": newFuncSpec("gwFrequency",
+			[]Param{
+				{"orbitalFrequency", "Hz", "orbital frequency of the binary"},
+			},
+			func(x []float64) float64 {
+				return gwFrequency(x[0])
+			}),
+
+		"orbitalFrequencyFromSeparation": newFuncSpec(
+			"orbitalFrequencyFromSeparation",
+			[]Param{
+				{"m1", "kg", "mass of the first body"},
+				{"m2", "kg", "mass of the second body"},
+				{"r", "m", "separation of the bodies"},
+			},
+			func(x []float64) float64 {
+				return orbitalFrequencyFromSeparation(x[0], x[1], x[2])
+			}),
+
+		"lightDeflectionAngle": newFuncSpec("lightDeflectionAngle",
+			[]Param{
+				{"M", "kg", "mass of the deflecting body"},
+				{"b", "m", "impact parameter"},
+			},
+			func(x []float64) float64 {
+				return lightDeflectionAngle(x[0], x[1])
+			}),
+
+		"impactParameterForDeflection": newFuncSpec(
+			"impactParameterForDeflection",
+			[]Param{
+				{"M", "kg", "mass of the deflecting body"},
+				{"deflectionAngle", "rad", "deflection angle"},
+			},
+			func(x []float64) float64 {
+				return impactParameterForDeflection(x[0], x[1])
+			}),
+
+		"planckSpectralRadiance": newFuncSpec("planckSpectralRadiance",
+			[]Param{
+				{"l", "m", "wavelength"},
+				{"T", "K", "temperature"},
+			},
+			func(x []float64) float64 {
+				return planckSpectralRadiance(x[0], x[1])
+			}),
+
+		"integratedPlanckRadiance": newFuncSpec(
+			"integratedPlanckRadiance",
+			[]Param{
+				{"T", "K", "temperature"},
+			},
+			func(x []float64) float64 {
+				return integratedPlanckRadiance(x[0])
+			}),
+
+		"surfaceGravityFromDensity": newFuncSpec(
+			"surfaceGravityFromDensity",
+			[]Param{
+				{"density", "kg/m^3", "density of the sphere"},
+				{"radius", "m", "radius of the sphere"},
+			},
+			func(x []float64) float64 {
+				return surfaceGravityFromDensity(x[0], x[1])
+			}),
+
+		"transverseDopplerShift": newFuncSpec("transverseDopplerShift",
+			[]Param{
+				{"f0", "Hz", "emitted frequency"},
+				{"v", "m/s", "velocity of the source"},
+			},
+			func(x []float64) float64 {
+				return transverseDopplerShift(x[0], x[1])
+			}),
+
+		"maxwellBoltzmannCDF": newFuncSpec("maxwellBoltzmannCDF",
+			[]Param{
+				{"v", "m/s", "speed"},
+				{"T", "K", "temperature"},
+				{"mass", "kg", "mass of a molecule"},
+			},
+			func(x []float64) float64 {
+				return maxwellBoltzmannCDF(x[0], x[1], x[2])
+			}),
+
+		"mostProbableSpeed": newFuncSpec("mostProbableSpeed",
+			[]Param{
+				{"T", "K", "temperature"},
+				{"molarMass", "kg/mol", "molar mass"},
+			},
+			func(x []float64) float64 {
+				return mostProbableSpeed(x[0], x[1])
+			}),
+
+		"meanSpeed": newFuncSpec("meanSpeed",
+			[]Param{
+				{"T", "K", "temperature"},
+				{"molarMass", "kg/mol", "molar mass"},
+			},
+			func(x []float64) float64 {
+				return meanSpeed(x[0], x[1])
+			}),
+
+		"rmsSpeed": newFuncSpec("rmsSpeed",
+			[]Param{
+				{"T", "K", "temperature"},
+				{"molarMass", "kg/mol", "molar mass"},
+			},
+			func(x []float64) float64 {
+				return rmsSpeed(x[0], x[1])
+			}),
+
+		"accretionLuminosity": newFuncSpec("accretionLuminosity",
+			[]Param{
+				{"M", "kg", "mass of the accreting body"},
+				{"mdot", "kg/s", "rate of accretion"},
+				{"r", "m", "radius the matter falls to"},
+			},
+			func(x []float64) float64 {
+				return accretionLuminosity(x[0], x[1], x[2])
+			}),
+
+		"eddingtonLuminosity": newFuncSpec("eddingtonLuminosity",
+			[]Param{
+				{"M", "kg", "mass of the body"},
+			},
+			func(x []float64) float64 {
+				return eddingtonLuminosity(x[0])
+			}),
+	}
+)
@@ -0,0 +1,177 @@
+/*
+ * Goplex Calculation Server
+ *
+ * Description: A small HTTP front-end that evaluates any function of the
+ *              registry, e.g. GET /calc/photonEnergy?l=4e-7
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//
+// Globals
+//
+var (
+
+	// default time a single calculation is allowed to take
+	defaultRequestTimeout = 5 * time.Second
+)
+
+// calcServer serves the functions of the registry over HTTP.
+type calcServer struct {
+
+	// time a single request is allowed to take before it is abandoned
+	timeout time.Duration
+
+	// handler of the individual routes
+	mux *http.ServeMux
+}
+
+// serverOption adjusts the configuration of a calcServer.
+type serverOption func(*calcServer)
+
+//! Option to set the time a single request is allowed to take
+/*
+ * @param    time.Duration    timeout per request --> timeout
+ *
+ * @result   serverOption     option to pass to newCalcServer
+ */
+func withRequestTimeout(timeout time.Duration) serverOption {
+	return func(s *calcServer) {
+		s.timeout = timeout
+	}
+}
+
+//! Create a new calculation server
+/*
+ * @param    ...serverOption    options to apply to the server --> opts
+ *
+ * @result   *calcServer        calculation server
+ */
+func newCalcServer(opts ...serverOption) *calcServer {
+
+	s := &calcServer{
+		timeout: defaultRequestTimeout,
+		mux:     http.NewServeMux(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.mux.Handle("/calc/", s.withTimeout(http.HandlerFunc(s.handleCalc)))
+
+	return s
+}
+
+//! Serve a single HTTP request
+/*
+ * @param    http.ResponseWriter    where the response is written --> w
+ * @param    *http.Request          request to serve              --> r
+ */
+func (s *calcServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+//! Wrap a handler so that it is abandoned, with a 503 response, if it
+//! runs longer than the timeout of the server
+/*
+ * @param    http.Handler    handler to wrap --> h
+ *
+ * @result   http.Handler    handler with a deadline
+ */
+func (s *calcServer) withTimeout(h http.Handler) http.Handler {
+	return http.TimeoutHandler(h, s.timeout, "calculation timed out\n")
+}
+
+//! Evaluate the function named in the path with the query arguments
+/*
+ * @param    http.ResponseWriter    where the result is written --> w
+ * @param    *http.Request          request to evaluate         --> r
+ */
+func (s *calcServer) handleCalc(w http.ResponseWriter, r *http.Request) {
+
+	name := strings.TrimPrefix(r.URL.Path, "/calc/")
+
+	spec, ok := Registry[name]
+	if !ok {
+		http.Error(w, "unknown function: "+name, http.StatusNotFound)
+		return
+	}
+
+	// the names of the parameters the function takes
+	known := make(map[string]bool)
+	for _, param := range spec.Params {
+		known[param.Name] = true
+	}
+
+	// convert each of the query values into a named argument
+	args := make(map[string]float64)
+	for param, values := range r.URL.Query() {
+
+		if !known[param] {
+			http.Error(w, "unknown parameter: "+param,
+				http.StatusBadRequest)
+			return
+		}
+
+		if len(values) != 1 {
+			http.Error(w, "repeated parameter: "+param,
+				http.StatusBadRequest)
+			return
+		}
+
+		value, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			http.Error(w, "invalid value for "+param,
+				http.StatusBadRequest)
+			return
+		}
+		args[param] = value
+	}
+
+	result, err := spec.Eval(args)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// JSON has no way to represent these, so refuse them outright
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		http.Error(w, "result is not a finite number",
+			http.StatusUnprocessableEntity)
+		return
+	}
+
+	// encode before writing anything, so that a failure can still be
+	// reported with an error status
+	var body bytes.Buffer
+	err = json.NewEncoder(&body).Encode(map[string]interface{}{
+		"name":   name,
+		"result": result,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body.Bytes())
+}
@@ -0,0 +1,60 @@
+/*
+ * Goplex Test Run
+ *
+ * Description: Keeps track of the tests that failed during a run of the
+ *              program, so that it can either stop at the first of them
+ *              or carry on and summarize them all at the end.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"fmt"
+	"os"
+)
+
+// testRun counts the failed tests of a single run of the program.
+type testRun struct {
+
+	// whether to carry on past a failed test, rather than exiting
+	keepGoing bool
+
+	// number of tests that have failed so far
+	failures int
+}
+
+//! Record a failed test, exiting straight away unless the run is to carry
+//! on past failures
+func (t *testRun) fail() {
+
+	t.failures++
+
+	if !t.keepGoing {
+		os.Exit(1)
+	}
+}
+
+//! Summary of the run, to print once all of the tests are done
+/*
+ * @result   string    summary of the run
+ */
+func (t *testRun) summary() string {
+
+	if t.failures == 0 {
+		return "All tests completed successfully!"
+	}
+
+	if t.failures == 1 {
+		return "1 test failed!"
+	}
+
+	return fmt.Sprintf("%d tests failed!", t.failures)
+}
@@ -0,0 +1,119 @@
+/*
+ * Goplex Units
+ *
+ * Description: Measurements tagged with their unit at compile time, so that
+ *              e.g. adding metres to seconds is rejected by the compiler.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"strconv"
+)
+
+// Unit is implemented by each of the phantom unit types below; they carry
+// no data and only exist to tag a Measurement.
+type Unit interface {
+	Symbol() string
+}
+
+// Meters is the SI unit of length.
+type Meters struct{}
+
+// Seconds is the SI unit of time.
+type Seconds struct{}
+
+// Kilograms is the SI unit of mass.
+type Kilograms struct{}
+
+// MetersPerSecond is the SI unit of velocity.
+type MetersPerSecond struct{}
+
+func (Meters) Symbol() string          { return "m" }
+func (Seconds) Symbol() string         { return "s" }
+func (Kilograms) Symbol() string       { return "kg" }
+func (MetersPerSecond) Symbol() string { return "m/s" }
+
+// Measurement is a value in the unit U.
+type Measurement[U Unit] struct {
+	Value float64
+}
+
+//! Sum of two measurements in the same unit
+/*
+ * @param    Measurement[U]    measurement to add --> other
+ *
+ * @result   Measurement[U]    sum, in the same unit
+ */
+func (m Measurement[U]) Add(other Measurement[U]) Measurement[U] {
+	return Measurement[U]{m.Value + other.Value}
+}
+
+//! Difference of two measurements in the same unit
+/*
+ * @param    Measurement[U]    measurement to subtract --> other
+ *
+ * @result   Measurement[U]    difference, in the same unit
+ */
+func (m Measurement[U]) Sub(other Measurement[U]) Measurement[U] {
+	return Measurement[U]{m.Value - other.Value}
+}
+
+//! Multiply a measurement by a dimensionless factor
+/*
+ * @param    float64           dimensionless factor --> k
+ *
+ * @result   Measurement[U]    scaled measurement, in the same unit
+ */
+func (m Measurement[U]) Scale(k float64) Measurement[U] {
+	return Measurement[U]{k * m.Value}
+}
+
+//! Format the measurement along with its unit, e.g. "9.8 m"
+/*
+ * @result   string    formatted measurement
+ */
+func (m Measurement[U]) String() string {
+	var unit U
+	return strconv.FormatFloat(m.Value, 'g', -1, 64) + " " + unit.Symbol()
+}
+
+//! Average speed over a distance covered in a given time
+/*
+ * @param    Measurement[Meters]             distance covered --> distance
+ * @param    Measurement[Seconds]            time taken       --> time
+ *
+ * @result   Measurement[MetersPerSecond]    average speed
+ */
+func Speed(distance Measurement[Meters],
+	time Measurement[Seconds]) Measurement[MetersPerSecond] {
+
+	// input validation
+	if time.Value == 0.0 {
+		return Measurement[MetersPerSecond]{}
+	}
+
+	return Measurement[MetersPerSecond]{distance.Value / time.Value}
+}
+
+//! Escape velocity from the surface of a body, see escapeVelocity
+/*
+ * @param    Measurement[Kilograms]          mass of the body   --> mass
+ * @param    Measurement[Meters]             radius of the body --> radius
+ *
+ * @result   Measurement[MetersPerSecond]    escape velocity
+ */
+func EscapeVelocity(mass Measurement[Kilograms],
+	radius Measurement[Meters]) Measurement[MetersPerSecond] {
+	return Measurement[MetersPerSecond]{
+		escapeVelocity(mass.Value, radius.Value),
+	}
+}
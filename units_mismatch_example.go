@@ -0,0 +1,33 @@
+//go:build ignore
+
+/*
+ * Goplex Units, Mismatch Example
+ *
+ * Description: Demonstrates that measurements in different units cannot be
+ *              mixed; building it into the package, i.e. without the build
+ *              constraint above, fails with e.g.
+ *
+ *              cannot use duration (variable of struct type
+ *              Measurement[Seconds]) as Measurement[Meters] value in
+ *              argument to distance.Add
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+func mismatchedUnits() {
+
+	distance := Measurement[Meters]{100}
+	duration := Measurement[Seconds]{10}
+
+	// metres and seconds cannot be added, so this does not compile
+	_ = distance.Add(duration)
+
+	// nor can a distance be passed where a speed is expected
+	var speed Measurement[MetersPerSecond] = distance
+	_ = speed
+}
@@ -0,0 +1,298 @@
+/*
+ * Goplex Utilities
+ *
+ * Description: A set of general helper functions that are used alongside
+ *              the scientific functions, e.g. for presenting results.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+//
+// Globals
+//
+var (
+
+	// SI prefixes, keyed by their power of ten
+	siPrefixes = map[int]string{
+		-24: "y", -21: "z", -18: "a", -15: "f", -12: "p", -9: "n",
+		-6: "u", -3: "m", 0: "", 3: "k", 6: "M", 9: "G", 12: "T",
+		15: "P", 18: "E", 21: "Z", 24: "Y",
+	}
+
+	// acceleration units, keyed by name, as multiples of m/s^2
+	accelerationUnits = map[string]float64{
+		"m/s^2":  1,
+		"km/s^2": 1000,
+	}
+)
+
+//! Format a value with the SI prefix nearest to its magnitude, to three
+//! significant figures, e.g. 0.00887 m --> "8.87 mm"
+/*
+ * @param    float64    value to format                --> value
+ * @param    string     unit of the value, e.g. "m"    --> unit
+ *
+ * @result   string     formatted value
+ */
+func formatSI(value float64, unit string) string {
+
+	// zero, infinities and NaN have no meaningful prefix
+	if value == 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return strconv.FormatFloat(value, 'g', 3, 64) + " " + unit
+	}
+
+	// find the nearest power of a thousand at or below the value
+	exponent := int(math.Floor(math.Log10(math.Abs(value))/3)) * 3
+
+	// round to three figures, which can carry it up to the next prefix
+	scaled := value / math.Pow(10, float64(exponent))
+	rounded, _ := strconv.ParseFloat(
+		strconv.FormatFloat(scaled, 'g', 3, 64), 64)
+	if math.Abs(rounded) >= 1000 {
+		exponent += 3
+	}
+
+	// keep to the range of prefixes that actually exist
+	if exponent > 24 {
+		exponent = 24
+	} else if exponent < -24 {
+		exponent = -24
+	}
+
+	scaled = value / math.Pow(10, float64(exponent))
+
+	return strconv.FormatFloat(scaled, 'g', 3, 64) + " " +
+		siPrefixes[exponent] + unit
+}
+
+//! Convert an acceleration from one unit to another
+/*
+ * @param    float64    acceleration to convert                   --> value
+ * @param    string     unit of the acceleration, e.g. "m/s^2"    --> from
+ * @param    string     unit to convert to, e.g. "km/s^2"         --> to
+ *
+ * @result   float64    converted acceleration
+ *           error      error message, if any
+ */
+func convertAcceleration(value float64, from, to string) (float64, error) {
+
+	fromFactor, ok := accelerationUnits[from]
+	if !ok {
+		return 0, fmt.Errorf("unknown acceleration unit %q", from)
+	}
+
+	toFactor, ok := accelerationUnits[to]
+	if !ok {
+		return 0, fmt.Errorf("unknown acceleration unit %q", to)
+	}
+
+	return value * fromFactor / toFactor, nil
+}
+
+//! Clamp a value to an interval; the bounds may be given in either order
+/*
+ * @param    float64    value to clamp    --> x
+ * @param    float64    lower bound       --> lo
+ * @param    float64    upper bound       --> hi
+ *
+ * @result   float64    x, limited to the interval [lo, hi]
+ */
+func clamp(x, lo, hi float64) float64 {
+
+	// swap the bounds if they were given the wrong way around
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	return math.Max(lo, math.Min(x, hi))
+}
+
+//! Linearly interpolate between two values
+/*
+ * @param    float64    value at t = 0           --> a
+ * @param    float64    value at t = 1           --> b
+ * @param    float64    interpolation factor     --> t
+ *
+ * @result   float64    interpolated value
+ */
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+//! Round a value to a given number of significant figures
+/*
+ * @param    float64    value to round              --> x
+ * @param    int        significant figures, if any --> n
+ *
+ * @result   float64    rounded value
+ */
+func roundToSigFigs(x float64, n int) float64 {
+
+	// input validation, leave the value at its full precision
+	if n <= 0 {
+		return x
+	}
+
+	// round via the decimal form, so the result prints without noise
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(x, 'g', n, 64), 64)
+	if err != nil {
+		return x
+	}
+
+	return rounded
+}
+
+//! Approximate the inverse square root of a value, trading accuracy for
+//! speed via the well known bit-level initial guess and two Newton steps;
+//! the relative error stays below 1e-5.
+/*
+ * @param    float64    value --> x
+ *
+ * @result   float64    approximately 1/sqrt(x)
+ */
+func fastInvSqrt(x float64) float64 {
+
+	// input validation
+	if x <= 0.0 {
+		return 0.0
+	}
+
+	// halve the exponent and negate it, by way of the raw bits
+	y := math.Float64frombits(0x5FE6EB50C7B537A9 - math.Float64bits(x)>>1)
+
+	// refine the guess with Newton's method
+	y = y * (1.5 - 0.5*x*y*y)
+	y = y * (1.5 - 0.5*x*y*y)
+
+	return y
+}
+
+//! Sort a set of values into bins of equal width, spanning from the
+//! smallest to the largest of them, e.g. to examine the spread of a sweep
+//! or of a Monte Carlo run. Values that are NaN or infinite are skipped.
+/*
+ * @param    []float64    values to bin        --> values
+ * @param    int          number of bins       --> bins
+ *
+ * @result   []int        count of values in each bin
+ *           []float64    edges of the bins, one more than the count
+ */
+func histogram(values []float64, bins int) ([]int, []float64) {
+
+	// input validation
+	if len(values) == 0 || bins <= 0 {
+		return []int{}, []float64{}
+	}
+
+	// find the range of the finite values
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+
+	// safety check, if none of them are finite there is nothing to bin
+	if lo > hi {
+		return []int{}, []float64{}
+	}
+
+	width := (hi - lo) / float64(bins)
+
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = lo + float64(i)*width
+	}
+	edges[bins] = hi
+
+	counts := make([]int, bins)
+	for _, v := range values {
+
+		// skip the values left out of the range above
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+
+		// safety check, if every value is the same they share one bin
+		if width == 0.0 {
+			counts[0]++
+			continue
+		}
+
+		// the largest value belongs to the last bin, not past it
+		i := int((v - lo) / width)
+		if i >= bins {
+			i = bins - 1
+		}
+		counts[i]++
+	}
+
+	return counts, edges
+}
+
+//! Sum a series of values via Kahan's compensated summation, which carries
+//! the rounding error of each addition along to the next, so that many
+//! small terms are not lost against a large running total
+/*
+ * @param    []float64    values to sum --> values
+ *
+ * @result   float64      sum of the values
+ */
+func kahanSum(values []float64) float64 {
+
+	sum := 0.0
+	compensation := 0.0
+
+	for _, v := range values {
+		y := v - compensation
+		t := sum + y
+		compensation = (t - sum) - y
+		sum = t
+	}
+
+	return sum
+}
+
+//! Convert an angle from degrees to radians
+/*
+ * @param    float64    angle, in degrees --> deg
+ *
+ * @result   float64    angle, in radians
+ */
+func degreesToRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+//! Divide two values, guarding against a zero divisor
+/*
+ * @param    float64    dividend --> a
+ * @param    float64    divisor  --> b
+ *
+ * @result   float64    a / b, or 0 if the divisor is zero
+ *           bool       false if the divisor is zero
+ */
+func safeDiv(a, b float64) (float64, bool) {
+
+	// safety check, ensure that the divisor is not zero
+	if b == 0.0 {
+		return 0.0, false
+	}
+
+	return a / b, true
+}
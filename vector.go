@@ -0,0 +1,214 @@
+/*
+ * Goplex Vectors
+ *
+ * Description: Three dimensional vector and matrix types, used for the
+ *              orbital and field functions that work in space rather than
+ *              along a single axis.
+ *
+ * Author: Robert Bisewski <contact@ibiscybernetics.com>
+ */
+
+//
+// Package
+//
+package main
+
+//
+// Imports
+//
+import (
+	"math"
+	"strconv"
+)
+
+// Vec3 is a vector in three dimensional space.
+type Vec3 struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+//! Sum of two vectors
+/*
+ * @param    Vec3    vector to add --> w
+ *
+ * @result   Vec3    v + w
+ */
+func (v Vec3) Add(w Vec3) Vec3 {
+	return Vec3{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
+}
+
+//! Difference of two vectors
+/*
+ * @param    Vec3    vector to subtract --> w
+ *
+ * @result   Vec3    v - w
+ */
+func (v Vec3) Sub(w Vec3) Vec3 {
+	return Vec3{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
+}
+
+//! Multiply a vector by a scalar
+/*
+ * @param    float64    scale factor --> k
+ *
+ * @result   Vec3       k * v
+ */
+func (v Vec3) Scale(k float64) Vec3 {
+	return Vec3{k * v.X, k * v.Y, k * v.Z}
+}
+
+//! Dot product of two vectors
+/*
+ * @param    Vec3       vector on the right-hand side --> w
+ *
+ * @result   float64    v . w
+ */
+func (v Vec3) Dot(w Vec3) float64 {
+	return v.X*w.X + v.Y*w.Y + v.Z*w.Z
+}
+
+//! Cross product of two vectors
+/*
+ * @param    Vec3    vector on the right-hand side --> w
+ *
+ * @result   Vec3    v x w
+ */
+func (v Vec3) Cross(w Vec3) Vec3 {
+	return Vec3{
+		X: v.Y*w.Z - v.Z*w.Y,
+		Y: v.Z*w.X - v.X*w.Z,
+		Z: v.X*w.Y - v.Y*w.X,
+	}
+}
+
+//! Format a vector as (x, y, z), in full
+/*
+ * @result   string    formatted vector
+ */
+func (v Vec3) String() string {
+	return v.Format(-1)
+}
+
+//! Format a vector as (x, y, z), to a number of significant figures, or
+//! to as many as are needed to represent it exactly if that is -1
+/*
+ * @param    int       significant figures, or -1 for all --> prec
+ *
+ * @result   string    formatted vector
+ */
+func (v Vec3) Format(prec int) string {
+	return "(" + strconv.FormatFloat(v.X, 'g', prec, 64) + ", " +
+		strconv.FormatFloat(v.Y, 'g', prec, 64) + ", " +
+		strconv.FormatFloat(v.Z, 'g', prec, 64) + ")"
+}
+
+//! Length of a vector
+/*
+ * @result   float64    Euclidean norm of v
+ */
+func (v Vec3) Norm() float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+// Mat3 is a 3x3 matrix, stored as rows, used to transform a Vec3 from one
+// frame of reference to another.
+type Mat3 [3][3]float64
+
+//! Multiply a vector by the matrix
+/*
+ * @param    Vec3    vector to transform --> v
+ *
+ * @result   Vec3    transformed vector
+ */
+func (m Mat3) Mul(v Vec3) Vec3 {
+	return Vec3{
+		X: m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+		Y: m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+		Z: m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+//! Rotation matrix about the X axis
+/*
+ * @param    float64    angle, in radians --> theta
+ *
+ * @result   Mat3       rotation matrix
+ */
+func RotationX(theta float64) Mat3 {
+
+	sin, cos := math.Sincos(theta)
+
+	return Mat3{
+		{1, 0, 0},
+		{0, cos, -sin},
+		{0, sin, cos},
+	}
+}
+
+//! Rotation matrix about the Y axis
+/*
+ * @param    float64    angle, in radians --> theta
+ *
+ * @result   Mat3       rotation matrix
+ */
+func RotationY(theta float64) Mat3 {
+
+	sin, cos := math.Sincos(theta)
+
+	return Mat3{
+		{cos, 0, sin},
+		{0, 1, 0},
+		{-sin, 0, cos},
+	}
+}
+
+//! Rotation matrix about the Z axis
+/*
+ * @param    float64    angle, in radians --> theta
+ *
+ * @result   Mat3       rotation matrix
+ */
+func RotationZ(theta float64) Mat3 {
+
+	sin, cos := math.Sincos(theta)
+
+	return Mat3{
+		{cos, -sin, 0},
+		{sin, cos, 0},
+		{0, 0, 1},
+	}
+}
+
+//! Rotation matrix about the X axis, as per RotationX, but with the angle
+//! given in degrees
+/*
+ * @param    float64    angle, in degrees --> thetaDeg
+ *
+ * @result   Mat3       rotation matrix
+ */
+func RotationXDeg(thetaDeg float64) Mat3 {
+	return RotationX(degreesToRadians(thetaDeg))
+}
+
+//! Rotation matrix about the Y axis, as per RotationY, but with the angle
+//! given in degrees
+/*
+ * @param    float64    angle, in degrees --> thetaDeg
+ *
+ * @result   Mat3       rotation matrix
+ */
+func RotationYDeg(thetaDeg float64) Mat3 {
+	return RotationY(degreesToRadians(thetaDeg))
+}
+
+//! Rotation matrix about the Z axis, as per RotationZ, but with the angle
+//! given in degrees
+/*
+ * @param    float64    angle, in degrees --> thetaDeg
+ *
+ * @result   Mat3       rotation matrix
+ */
+func RotationZDeg(thetaDeg float64) Mat3 {
+	return RotationZ(degreesToRadians(thetaDeg))
+}